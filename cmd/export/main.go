@@ -0,0 +1,41 @@
+// Command export writes the indexed pages to JSON Lines, a Markdown tree or
+// Parquet. It reads the collection configured by the QDRANT_* variables.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/aiocean/shopify-doc-extractor/implement/export"
+)
+
+func main() {
+	format := flag.String("format", "jsonl", "output format: jsonl, markdown or parquet")
+	out := flag.String("out", "", "output file, or directory for markdown")
+	prefix := flag.String("prefix", "", "only export pages whose URL starts with prefix")
+	filter := flag.String("filter", "", "only export pages with a point matching the filter expression")
+	flag.Parse()
+
+	if *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	w, err := export.NewWriter(export.Format(*format), *out)
+	if err != nil {
+		log.Fatalf("failed to create output: %v", err)
+	}
+
+	count, err := export.Export(ctx, w, *prefix, *filter)
+	if err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+
+	log.Printf("exported %d pages to %s", count, *out)
+}
@@ -0,0 +1,111 @@
+// Command llmstxt writes llms.txt, an index of the documentation pages with
+// one-line summaries, and llms-full.txt, their Markdown concatenated. Pages
+// are read from the collection configured by the QDRANT_* variables, or from
+// a JSON Lines file of DocPage records such as the one cmd/export writes.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+	"github.com/aiocean/shopify-doc-extractor/implement/llmstxt"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func main() {
+	out := flag.String("out", "", "directory to write llms.txt and llms-full.txt to")
+	in := flag.String("in", "", "JSON Lines file of DocPage records to read instead of the index")
+	prefix := flag.String("prefix", "", "only include pages whose URL starts with prefix")
+	filter := flag.String("filter", "", "only include indexed pages with a point matching the filter expression")
+	title := flag.String("title", "", "title of the files")
+	description := flag.String("description", "", "description of the files")
+	flag.Parse()
+
+	if *out == "" || (*in != "" && *filter != "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var pages []*extractorv1.DocPage
+	var err error
+	if *in != "" {
+		pages, err = readDocPages(*in)
+	} else {
+		pages, err = indexedPages(ctx, *prefix, *filter)
+	}
+	if err != nil {
+		log.Fatalf("failed to read pages: %v", err)
+	}
+
+	files := llmstxt.Generate(pages, llmstxt.Options{
+		Prefix:      *prefix,
+		Title:       *title,
+		Description: *description,
+	})
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatalf("failed to create output: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(*out, "llms.txt"), []byte(files.LlmsTxt), 0o644); err != nil {
+		log.Fatalf("failed to write llms.txt: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(*out, "llms-full.txt"), []byte(files.LlmsFullTxt), 0o644); err != nil {
+		log.Fatalf("failed to write llms-full.txt: %v", err)
+	}
+
+	log.Printf("wrote %d pages to %s", files.PageCount, *out)
+}
+
+func indexedPages(ctx context.Context, prefix, filter string) ([]*extractorv1.DocPage, error) {
+	storedPages, err := indexer.ListStoredPages(ctx, prefix, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]*extractorv1.DocPage, 0, len(storedPages))
+	for _, page := range storedPages {
+		pages = append(pages, page.DocPage)
+	}
+	return pages, nil
+}
+
+func readDocPages(path string) ([]*extractorv1.DocPage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var pages []*extractorv1.DocPage
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(data)) > 0 {
+			page := &extractorv1.DocPage{}
+			if err := protojson.Unmarshal(data, page); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			pages = append(pages, page)
+		}
+		if errors.Is(err, io.EOF) {
+			return pages, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -1,19 +1,37 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"fmt"
+	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/aiocean/shopify-doc-extractor/gen/extractor/v1/extractorv1connect"
 	"github.com/aiocean/shopify-doc-extractor/gen/indexer/v1/indexerv1connect"
+	"github.com/aiocean/shopify-doc-extractor/gen/pipeline/v1/pipelinev1connect"
 	"github.com/aiocean/shopify-doc-extractor/implement/extractor"
 	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+	"github.com/aiocean/shopify-doc-extractor/implement/pipeline"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
 func main() {
+	if err := indexer.CheckEmbeddingTemplates(); err != nil {
+		log.Fatalf("failed to load embedding templates: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	if err := indexer.CheckCollection(ctx); err != nil {
+		log.Fatalf("failed to check collection: %v", err)
+	}
+	cancel()
+
+	indexer.LoadTokenizer()
+
 	mux := http.NewServeMux()
 
 	extractorPath, extractorHandler := extractorv1connect.NewExtractorServiceHandler(&extractor.ExtractorServer{})
@@ -22,6 +40,12 @@ func main() {
 	indexerPath, indexerHandler := indexerv1connect.NewIndexerServiceHandler(&indexer.IndexerServer{})
 	mux.Handle(indexerPath, indexerHandler)
 
+	pipelinePath, pipelineHandler := pipelinev1connect.NewPipelineServiceHandler(&pipeline.PipelineServer{})
+	mux.Handle(pipelinePath, pipelineHandler)
+
+	// Counters such as the embedding cache hits.
+	mux.Handle("/debug/vars", expvar.Handler())
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
@@ -0,0 +1,107 @@
+// Command snapshot exports the vector index with its embeddings to a file,
+// and imports such a file into a new collection version, so a prebuilt
+// index can be shipped to another environment without embedding the pages
+// again. It uses the Qdrant server configured by the QDRANT_* variables.
+//
+//	snapshot export -out index.jsonl.gz
+//	snapshot import -in index.jsonl.gz -activate
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	switch os.Args[1] {
+	case "export":
+		exportSnapshot(ctx, os.Args[2:])
+	case "import":
+		importSnapshot(ctx, os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: snapshot export -out file [-collection name]")
+	fmt.Fprintln(os.Stderr, "       snapshot import -in file [-activate] [-drop-legacy]")
+	os.Exit(2)
+}
+
+func exportSnapshot(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	out := flags.String("out", "", "snapshot file to write")
+	collection := flags.String("collection", "", "collection version to export, the active collection by default")
+	flags.Parse(args)
+
+	if *out == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		log.Fatalf("failed to create output: %v", err)
+	}
+
+	count, err := indexer.ExportIndex(ctx, indexer.NewQdrantPointStore(*collection), file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+
+	log.Printf("exported %d points to %s", count, *out)
+}
+
+func importSnapshot(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	in := flags.String("in", "", "snapshot file to read")
+	activate := flags.Bool("activate", false, "point the collection alias at the imported version")
+	dropLegacy := flags.Bool("drop-legacy", false, "with -activate, delete a legacy collection named like the alias")
+	flags.Parse(args)
+
+	if *in == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	file, err := os.Open(*in)
+	if err != nil {
+		log.Fatalf("failed to open snapshot: %v", err)
+	}
+	defer file.Close()
+
+	version, err := indexer.CreateCollectionVersion(ctx)
+	if err != nil {
+		log.Fatalf("failed to create collection version: %v", err)
+	}
+
+	count, err := indexer.ImportIndex(ctx, indexer.NewQdrantPointStore(version.Name), file)
+	if err != nil {
+		log.Fatalf("import into %s failed after %d points: %v", version.Name, count, err)
+	}
+	log.Printf("imported %d points into %s", count, version.Name)
+
+	if *activate {
+		if _, err := indexer.ActivateCollectionVersion(ctx, version.Name, *dropLegacy); err != nil {
+			log.Fatalf("failed to activate %s: %v", version.Name, err)
+		}
+		log.Printf("activated %s", version.Name)
+	}
+}
@@ -20,12 +20,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExtractionIssue_Severity int32
+
+const (
+	ExtractionIssue_SEVERITY_UNSPECIFIED ExtractionIssue_Severity = 0
+	ExtractionIssue_SEVERITY_WARNING     ExtractionIssue_Severity = 1
+	ExtractionIssue_SEVERITY_ERROR       ExtractionIssue_Severity = 2
+)
+
+// Enum value maps for ExtractionIssue_Severity.
+var (
+	ExtractionIssue_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "SEVERITY_WARNING",
+		2: "SEVERITY_ERROR",
+	}
+	ExtractionIssue_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"SEVERITY_WARNING":     1,
+		"SEVERITY_ERROR":       2,
+	}
+)
+
+func (x ExtractionIssue_Severity) Enum() *ExtractionIssue_Severity {
+	p := new(ExtractionIssue_Severity)
+	*p = x
+	return p
+}
+
+func (x ExtractionIssue_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExtractionIssue_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_extractor_v1_extractor_proto_enumTypes[0].Descriptor()
+}
+
+func (ExtractionIssue_Severity) Type() protoreflect.EnumType {
+	return &file_extractor_v1_extractor_proto_enumTypes[0]
+}
+
+func (x ExtractionIssue_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExtractionIssue_Severity.Descriptor instead.
+func (ExtractionIssue_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{3, 0}
+}
+
 type ExtractRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// url of the page to fetch. Exactly one of url and html must be set.
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// strict turns extraction warnings into failures.
+	Strict bool `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
+	// html is the raw HTML of an already fetched page.
+	Html string `protobuf:"bytes,3,opt,name=html,proto3" json:"html,omitempty"`
+	// base_url is the URL the html was served from. It is required with html.
+	// The source URLs of the page and its sections are derived from it, and
+	// relative links and images in the Markdown are resolved against it.
+	BaseUrl string `protobuf:"bytes,4,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
 }
 
 func (x *ExtractRequest) Reset() {
@@ -67,12 +125,34 @@ func (x *ExtractRequest) GetUrl() string {
 	return ""
 }
 
+func (x *ExtractRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *ExtractRequest) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *ExtractRequest) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
 type ExtractResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DocPage *DocPage `protobuf:"bytes,1,opt,name=doc_page,json=docPage,proto3" json:"doc_page,omitempty"`
+	DocPage *DocPage          `protobuf:"bytes,1,opt,name=doc_page,json=docPage,proto3" json:"doc_page,omitempty"`
+	Report  *ExtractionReport `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
 }
 
 func (x *ExtractResponse) Reset() {
@@ -114,6 +194,125 @@ func (x *ExtractResponse) GetDocPage() *DocPage {
 	return nil
 }
 
+func (x *ExtractResponse) GetReport() *ExtractionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// ExtractionReport lists the problems found by the sanity checks run on an
+// extracted page. It is attached as an error detail when extraction fails.
+type ExtractionReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issues []*ExtractionIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *ExtractionReport) Reset() {
+	*x = ExtractionReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extractor_v1_extractor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractionReport) ProtoMessage() {}
+
+func (x *ExtractionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_extractor_v1_extractor_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractionReport.ProtoReflect.Descriptor instead.
+func (*ExtractionReport) Descriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExtractionReport) GetIssues() []*ExtractionIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ExtractionIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check    string                   `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Severity ExtractionIssue_Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=extractor.v1.ExtractionIssue_Severity" json:"severity,omitempty"`
+	Message  string                   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ExtractionIssue) Reset() {
+	*x = ExtractionIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extractor_v1_extractor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractionIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractionIssue) ProtoMessage() {}
+
+func (x *ExtractionIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_extractor_v1_extractor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractionIssue.ProtoReflect.Descriptor instead.
+func (*ExtractionIssue) Descriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExtractionIssue) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *ExtractionIssue) GetSeverity() ExtractionIssue_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return ExtractionIssue_SEVERITY_UNSPECIFIED
+}
+
+func (x *ExtractionIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type DocPage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -128,7 +327,7 @@ type DocPage struct {
 func (x *DocPage) Reset() {
 	*x = DocPage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_extractor_v1_extractor_proto_msgTypes[2]
+		mi := &file_extractor_v1_extractor_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -141,7 +340,7 @@ func (x *DocPage) String() string {
 func (*DocPage) ProtoMessage() {}
 
 func (x *DocPage) ProtoReflect() protoreflect.Message {
-	mi := &file_extractor_v1_extractor_proto_msgTypes[2]
+	mi := &file_extractor_v1_extractor_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +353,7 @@ func (x *DocPage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocPage.ProtoReflect.Descriptor instead.
 func (*DocPage) Descriptor() ([]byte, []int) {
-	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{2}
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DocPage) GetSourceTitle() string {
@@ -201,7 +400,7 @@ type DocSection struct {
 func (x *DocSection) Reset() {
 	*x = DocSection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_extractor_v1_extractor_proto_msgTypes[3]
+		mi := &file_extractor_v1_extractor_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -214,7 +413,7 @@ func (x *DocSection) String() string {
 func (*DocSection) ProtoMessage() {}
 
 func (x *DocSection) ProtoReflect() protoreflect.Message {
-	mi := &file_extractor_v1_extractor_proto_msgTypes[3]
+	mi := &file_extractor_v1_extractor_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +426,7 @@ func (x *DocSection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocSection.ProtoReflect.Descriptor instead.
 func (*DocSection) Descriptor() ([]byte, []int) {
-	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{3}
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DocSection) GetSectionTitle() string {
@@ -272,55 +471,445 @@ func (x *DocSection) GetContentMarkdown() string {
 	return ""
 }
 
+type ExtractBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Urls []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	// concurrency caps the number of pages extracted at once. Zero uses the
+	// server default.
+	Concurrency int32 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Strict      bool  `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (x *ExtractBatchRequest) Reset() {
+	*x = ExtractBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extractor_v1_extractor_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractBatchRequest) ProtoMessage() {}
+
+func (x *ExtractBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extractor_v1_extractor_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractBatchRequest.ProtoReflect.Descriptor instead.
+func (*ExtractBatchRequest) Descriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExtractBatchRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *ExtractBatchRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *ExtractBatchRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type ExtractBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are in the same order as the requested urls.
+	Results []*ExtractBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ExtractBatchResponse) Reset() {
+	*x = ExtractBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extractor_v1_extractor_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractBatchResponse) ProtoMessage() {}
+
+func (x *ExtractBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extractor_v1_extractor_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractBatchResponse.ProtoReflect.Descriptor instead.
+func (*ExtractBatchResponse) Descriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExtractBatchResponse) GetResults() []*ExtractBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExtractBatchStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Urls        []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	Concurrency int32    `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Strict      bool     `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (x *ExtractBatchStreamRequest) Reset() {
+	*x = ExtractBatchStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extractor_v1_extractor_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractBatchStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractBatchStreamRequest) ProtoMessage() {}
+
+func (x *ExtractBatchStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extractor_v1_extractor_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractBatchStreamRequest.ProtoReflect.Descriptor instead.
+func (*ExtractBatchStreamRequest) Descriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExtractBatchStreamRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *ExtractBatchStreamRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *ExtractBatchStreamRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type ExtractBatchStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *ExtractBatchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ExtractBatchStreamResponse) Reset() {
+	*x = ExtractBatchStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extractor_v1_extractor_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractBatchStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractBatchStreamResponse) ProtoMessage() {}
+
+func (x *ExtractBatchStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extractor_v1_extractor_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractBatchStreamResponse.ProtoReflect.Descriptor instead.
+func (*ExtractBatchStreamResponse) Descriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExtractBatchStreamResponse) GetResult() *ExtractBatchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// ExtractBatchResult is the outcome of extracting one url of a batch. On
+// failure doc_page is empty and error_code holds the connect error code.
+type ExtractBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// index of the url in the request.
+	Index        int32             `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	DocPage      *DocPage          `protobuf:"bytes,3,opt,name=doc_page,json=docPage,proto3" json:"doc_page,omitempty"`
+	Report       *ExtractionReport `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`
+	ErrorCode    string            `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage string            `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *ExtractBatchResult) Reset() {
+	*x = ExtractBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extractor_v1_extractor_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractBatchResult) ProtoMessage() {}
+
+func (x *ExtractBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_extractor_v1_extractor_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractBatchResult.ProtoReflect.Descriptor instead.
+func (*ExtractBatchResult) Descriptor() ([]byte, []int) {
+	return file_extractor_v1_extractor_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExtractBatchResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExtractBatchResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ExtractBatchResult) GetDocPage() *DocPage {
+	if x != nil {
+		return x.DocPage
+	}
+	return nil
+}
+
+func (x *ExtractBatchResult) GetReport() *ExtractionReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ExtractBatchResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ExtractBatchResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
 var File_extractor_v1_extractor_proto protoreflect.FileDescriptor
 
 var file_extractor_v1_extractor_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65,
 	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x22, 0x0a, 0x0e,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x69, 0x0a, 0x0e,
 	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
 	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x22, 0x43, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x67, 0x65, 0x52, 0x07, 0x64, 0x6f,
-	0x63, 0x50, 0x61, 0x67, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x07, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
-	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12,
-	0x3b, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x64, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x22, 0xdb, 0x01, 0x0a, 0x0a,
-	0x44, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x29,
-	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f,
-	0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x32, 0x5c, 0x0a, 0x10, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a,
-	0x07, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x73, 0x68,
-	0x6f, 0x70, 0x69, 0x66, 0x79, 0x2d, 0x64, 0x6f, 0x63, 0x2d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x7b, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x6f,
+	0x63, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x07, 0x64, 0x6f, 0x63, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x49, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22,
+	0xd5, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4e, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x22, 0xb3, 0x01, 0x0a, 0x07, 0x44, 0x6f, 0x63, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x22, 0xdb, 0x01,
+	0x0a, 0x0a, 0x44, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x63, 0x0a, 0x13, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x22, 0x52, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22,
+	0x56, 0x0a, 0x1a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x64, 0x6f, 0x63, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x32, 0xa2, 0x02, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x27, 0x2e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f,
+	0x73, 0x68, 0x6f, 0x70, 0x69, 0x66, 0x79, 0x2d, 0x64, 0x6f, 0x63, 0x2d, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -335,23 +924,43 @@ func file_extractor_v1_extractor_proto_rawDescGZIP() []byte {
 	return file_extractor_v1_extractor_proto_rawDescData
 }
 
-var file_extractor_v1_extractor_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_extractor_v1_extractor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_extractor_v1_extractor_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_extractor_v1_extractor_proto_goTypes = []any{
-	(*ExtractRequest)(nil),  // 0: extractor.v1.ExtractRequest
-	(*ExtractResponse)(nil), // 1: extractor.v1.ExtractResponse
-	(*DocPage)(nil),         // 2: extractor.v1.DocPage
-	(*DocSection)(nil),      // 3: extractor.v1.DocSection
+	(ExtractionIssue_Severity)(0),      // 0: extractor.v1.ExtractionIssue.Severity
+	(*ExtractRequest)(nil),             // 1: extractor.v1.ExtractRequest
+	(*ExtractResponse)(nil),            // 2: extractor.v1.ExtractResponse
+	(*ExtractionReport)(nil),           // 3: extractor.v1.ExtractionReport
+	(*ExtractionIssue)(nil),            // 4: extractor.v1.ExtractionIssue
+	(*DocPage)(nil),                    // 5: extractor.v1.DocPage
+	(*DocSection)(nil),                 // 6: extractor.v1.DocSection
+	(*ExtractBatchRequest)(nil),        // 7: extractor.v1.ExtractBatchRequest
+	(*ExtractBatchResponse)(nil),       // 8: extractor.v1.ExtractBatchResponse
+	(*ExtractBatchStreamRequest)(nil),  // 9: extractor.v1.ExtractBatchStreamRequest
+	(*ExtractBatchStreamResponse)(nil), // 10: extractor.v1.ExtractBatchStreamResponse
+	(*ExtractBatchResult)(nil),         // 11: extractor.v1.ExtractBatchResult
 }
 var file_extractor_v1_extractor_proto_depIdxs = []int32{
-	2, // 0: extractor.v1.ExtractResponse.doc_page:type_name -> extractor.v1.DocPage
-	3, // 1: extractor.v1.DocPage.doc_sections:type_name -> extractor.v1.DocSection
-	0, // 2: extractor.v1.ExtractorService.Extract:input_type -> extractor.v1.ExtractRequest
-	1, // 3: extractor.v1.ExtractorService.Extract:output_type -> extractor.v1.ExtractResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: extractor.v1.ExtractResponse.doc_page:type_name -> extractor.v1.DocPage
+	3,  // 1: extractor.v1.ExtractResponse.report:type_name -> extractor.v1.ExtractionReport
+	4,  // 2: extractor.v1.ExtractionReport.issues:type_name -> extractor.v1.ExtractionIssue
+	0,  // 3: extractor.v1.ExtractionIssue.severity:type_name -> extractor.v1.ExtractionIssue.Severity
+	6,  // 4: extractor.v1.DocPage.doc_sections:type_name -> extractor.v1.DocSection
+	11, // 5: extractor.v1.ExtractBatchResponse.results:type_name -> extractor.v1.ExtractBatchResult
+	11, // 6: extractor.v1.ExtractBatchStreamResponse.result:type_name -> extractor.v1.ExtractBatchResult
+	5,  // 7: extractor.v1.ExtractBatchResult.doc_page:type_name -> extractor.v1.DocPage
+	3,  // 8: extractor.v1.ExtractBatchResult.report:type_name -> extractor.v1.ExtractionReport
+	1,  // 9: extractor.v1.ExtractorService.Extract:input_type -> extractor.v1.ExtractRequest
+	7,  // 10: extractor.v1.ExtractorService.ExtractBatch:input_type -> extractor.v1.ExtractBatchRequest
+	9,  // 11: extractor.v1.ExtractorService.ExtractBatchStream:input_type -> extractor.v1.ExtractBatchStreamRequest
+	2,  // 12: extractor.v1.ExtractorService.Extract:output_type -> extractor.v1.ExtractResponse
+	8,  // 13: extractor.v1.ExtractorService.ExtractBatch:output_type -> extractor.v1.ExtractBatchResponse
+	10, // 14: extractor.v1.ExtractorService.ExtractBatchStream:output_type -> extractor.v1.ExtractBatchStreamResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_extractor_v1_extractor_proto_init() }
@@ -385,7 +994,7 @@ func file_extractor_v1_extractor_proto_init() {
 			}
 		}
 		file_extractor_v1_extractor_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*DocPage); i {
+			switch v := v.(*ExtractionReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -397,6 +1006,30 @@ func file_extractor_v1_extractor_proto_init() {
 			}
 		}
 		file_extractor_v1_extractor_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtractionIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extractor_v1_extractor_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DocPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extractor_v1_extractor_proto_msgTypes[5].Exporter = func(v any, i int) any {
 			switch v := v.(*DocSection); i {
 			case 0:
 				return &v.state
@@ -408,19 +1041,80 @@ func file_extractor_v1_extractor_proto_init() {
 				return nil
 			}
 		}
+		file_extractor_v1_extractor_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtractBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extractor_v1_extractor_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtractBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extractor_v1_extractor_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtractBatchStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extractor_v1_extractor_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtractBatchStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extractor_v1_extractor_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtractBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_extractor_v1_extractor_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_extractor_v1_extractor_proto_goTypes,
 		DependencyIndexes: file_extractor_v1_extractor_proto_depIdxs,
+		EnumInfos:         file_extractor_v1_extractor_proto_enumTypes,
 		MessageInfos:      file_extractor_v1_extractor_proto_msgTypes,
 	}.Build()
 	File_extractor_v1_extractor_proto = out.File
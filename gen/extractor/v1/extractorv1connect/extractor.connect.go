@@ -36,17 +36,28 @@ const (
 	// ExtractorServiceExtractProcedure is the fully-qualified name of the ExtractorService's Extract
 	// RPC.
 	ExtractorServiceExtractProcedure = "/extractor.v1.ExtractorService/Extract"
+	// ExtractorServiceExtractBatchProcedure is the fully-qualified name of the ExtractorService's
+	// ExtractBatch RPC.
+	ExtractorServiceExtractBatchProcedure = "/extractor.v1.ExtractorService/ExtractBatch"
+	// ExtractorServiceExtractBatchStreamProcedure is the fully-qualified name of the ExtractorService's
+	// ExtractBatchStream RPC.
+	ExtractorServiceExtractBatchStreamProcedure = "/extractor.v1.ExtractorService/ExtractBatchStream"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	extractorServiceServiceDescriptor       = v1.File_extractor_v1_extractor_proto.Services().ByName("ExtractorService")
-	extractorServiceExtractMethodDescriptor = extractorServiceServiceDescriptor.Methods().ByName("Extract")
+	extractorServiceServiceDescriptor                  = v1.File_extractor_v1_extractor_proto.Services().ByName("ExtractorService")
+	extractorServiceExtractMethodDescriptor            = extractorServiceServiceDescriptor.Methods().ByName("Extract")
+	extractorServiceExtractBatchMethodDescriptor       = extractorServiceServiceDescriptor.Methods().ByName("ExtractBatch")
+	extractorServiceExtractBatchStreamMethodDescriptor = extractorServiceServiceDescriptor.Methods().ByName("ExtractBatchStream")
 )
 
 // ExtractorServiceClient is a client for the extractor.v1.ExtractorService service.
 type ExtractorServiceClient interface {
 	Extract(context.Context, *connect.Request[v1.ExtractRequest]) (*connect.Response[v1.ExtractResponse], error)
+	ExtractBatch(context.Context, *connect.Request[v1.ExtractBatchRequest]) (*connect.Response[v1.ExtractBatchResponse], error)
+	// ExtractBatchStream sends each result as soon as its page is extracted.
+	ExtractBatchStream(context.Context, *connect.Request[v1.ExtractBatchStreamRequest]) (*connect.ServerStreamForClient[v1.ExtractBatchStreamResponse], error)
 }
 
 // NewExtractorServiceClient constructs a client for the extractor.v1.ExtractorService service. By
@@ -65,12 +76,26 @@ func NewExtractorServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(extractorServiceExtractMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		extractBatch: connect.NewClient[v1.ExtractBatchRequest, v1.ExtractBatchResponse](
+			httpClient,
+			baseURL+ExtractorServiceExtractBatchProcedure,
+			connect.WithSchema(extractorServiceExtractBatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		extractBatchStream: connect.NewClient[v1.ExtractBatchStreamRequest, v1.ExtractBatchStreamResponse](
+			httpClient,
+			baseURL+ExtractorServiceExtractBatchStreamProcedure,
+			connect.WithSchema(extractorServiceExtractBatchStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // extractorServiceClient implements ExtractorServiceClient.
 type extractorServiceClient struct {
-	extract *connect.Client[v1.ExtractRequest, v1.ExtractResponse]
+	extract            *connect.Client[v1.ExtractRequest, v1.ExtractResponse]
+	extractBatch       *connect.Client[v1.ExtractBatchRequest, v1.ExtractBatchResponse]
+	extractBatchStream *connect.Client[v1.ExtractBatchStreamRequest, v1.ExtractBatchStreamResponse]
 }
 
 // Extract calls extractor.v1.ExtractorService.Extract.
@@ -78,9 +103,22 @@ func (c *extractorServiceClient) Extract(ctx context.Context, req *connect.Reque
 	return c.extract.CallUnary(ctx, req)
 }
 
+// ExtractBatch calls extractor.v1.ExtractorService.ExtractBatch.
+func (c *extractorServiceClient) ExtractBatch(ctx context.Context, req *connect.Request[v1.ExtractBatchRequest]) (*connect.Response[v1.ExtractBatchResponse], error) {
+	return c.extractBatch.CallUnary(ctx, req)
+}
+
+// ExtractBatchStream calls extractor.v1.ExtractorService.ExtractBatchStream.
+func (c *extractorServiceClient) ExtractBatchStream(ctx context.Context, req *connect.Request[v1.ExtractBatchStreamRequest]) (*connect.ServerStreamForClient[v1.ExtractBatchStreamResponse], error) {
+	return c.extractBatchStream.CallServerStream(ctx, req)
+}
+
 // ExtractorServiceHandler is an implementation of the extractor.v1.ExtractorService service.
 type ExtractorServiceHandler interface {
 	Extract(context.Context, *connect.Request[v1.ExtractRequest]) (*connect.Response[v1.ExtractResponse], error)
+	ExtractBatch(context.Context, *connect.Request[v1.ExtractBatchRequest]) (*connect.Response[v1.ExtractBatchResponse], error)
+	// ExtractBatchStream sends each result as soon as its page is extracted.
+	ExtractBatchStream(context.Context, *connect.Request[v1.ExtractBatchStreamRequest], *connect.ServerStream[v1.ExtractBatchStreamResponse]) error
 }
 
 // NewExtractorServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -95,10 +133,26 @@ func NewExtractorServiceHandler(svc ExtractorServiceHandler, opts ...connect.Han
 		connect.WithSchema(extractorServiceExtractMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	extractorServiceExtractBatchHandler := connect.NewUnaryHandler(
+		ExtractorServiceExtractBatchProcedure,
+		svc.ExtractBatch,
+		connect.WithSchema(extractorServiceExtractBatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	extractorServiceExtractBatchStreamHandler := connect.NewServerStreamHandler(
+		ExtractorServiceExtractBatchStreamProcedure,
+		svc.ExtractBatchStream,
+		connect.WithSchema(extractorServiceExtractBatchStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/extractor.v1.ExtractorService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ExtractorServiceExtractProcedure:
 			extractorServiceExtractHandler.ServeHTTP(w, r)
+		case ExtractorServiceExtractBatchProcedure:
+			extractorServiceExtractBatchHandler.ServeHTTP(w, r)
+		case ExtractorServiceExtractBatchStreamProcedure:
+			extractorServiceExtractBatchStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -111,3 +165,11 @@ type UnimplementedExtractorServiceHandler struct{}
 func (UnimplementedExtractorServiceHandler) Extract(context.Context, *connect.Request[v1.ExtractRequest]) (*connect.Response[v1.ExtractResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("extractor.v1.ExtractorService.Extract is not implemented"))
 }
+
+func (UnimplementedExtractorServiceHandler) ExtractBatch(context.Context, *connect.Request[v1.ExtractBatchRequest]) (*connect.Response[v1.ExtractBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("extractor.v1.ExtractorService.ExtractBatch is not implemented"))
+}
+
+func (UnimplementedExtractorServiceHandler) ExtractBatchStream(context.Context, *connect.Request[v1.ExtractBatchStreamRequest], *connect.ServerStream[v1.ExtractBatchStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("extractor.v1.ExtractorService.ExtractBatchStream is not implemented"))
+}
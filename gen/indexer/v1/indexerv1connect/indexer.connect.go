@@ -35,17 +35,83 @@ const (
 const (
 	// IndexerServiceIndexProcedure is the fully-qualified name of the IndexerService's Index RPC.
 	IndexerServiceIndexProcedure = "/indexer.v1.IndexerService/Index"
+	// IndexerServiceListUrlsProcedure is the fully-qualified name of the IndexerService's ListUrls RPC.
+	IndexerServiceListUrlsProcedure = "/indexer.v1.IndexerService/ListUrls"
+	// IndexerServiceDeletePageProcedure is the fully-qualified name of the IndexerService's DeletePage
+	// RPC.
+	IndexerServiceDeletePageProcedure = "/indexer.v1.IndexerService/DeletePage"
+	// IndexerServiceDeleteByPrefixProcedure is the fully-qualified name of the IndexerService's
+	// DeleteByPrefix RPC.
+	IndexerServiceDeleteByPrefixProcedure = "/indexer.v1.IndexerService/DeleteByPrefix"
+	// IndexerServicePurgeProcedure is the fully-qualified name of the IndexerService's Purge RPC.
+	IndexerServicePurgeProcedure = "/indexer.v1.IndexerService/Purge"
+	// IndexerServiceSearchProcedure is the fully-qualified name of the IndexerService's Search RPC.
+	IndexerServiceSearchProcedure = "/indexer.v1.IndexerService/Search"
+	// IndexerServiceListCollectionVersionsProcedure is the fully-qualified name of the IndexerService's
+	// ListCollectionVersions RPC.
+	IndexerServiceListCollectionVersionsProcedure = "/indexer.v1.IndexerService/ListCollectionVersions"
+	// IndexerServiceCreateCollectionVersionProcedure is the fully-qualified name of the
+	// IndexerService's CreateCollectionVersion RPC.
+	IndexerServiceCreateCollectionVersionProcedure = "/indexer.v1.IndexerService/CreateCollectionVersion"
+	// IndexerServiceActivateCollectionVersionProcedure is the fully-qualified name of the
+	// IndexerService's ActivateCollectionVersion RPC.
+	IndexerServiceActivateCollectionVersionProcedure = "/indexer.v1.IndexerService/ActivateCollectionVersion"
+	// IndexerServiceRollbackCollectionVersionProcedure is the fully-qualified name of the
+	// IndexerService's RollbackCollectionVersion RPC.
+	IndexerServiceRollbackCollectionVersionProcedure = "/indexer.v1.IndexerService/RollbackCollectionVersion"
+	// IndexerServiceDeleteCollectionVersionProcedure is the fully-qualified name of the
+	// IndexerService's DeleteCollectionVersion RPC.
+	IndexerServiceDeleteCollectionVersionProcedure = "/indexer.v1.IndexerService/DeleteCollectionVersion"
+	// IndexerServiceWarmEmbeddingCacheProcedure is the fully-qualified name of the IndexerService's
+	// WarmEmbeddingCache RPC.
+	IndexerServiceWarmEmbeddingCacheProcedure = "/indexer.v1.IndexerService/WarmEmbeddingCache"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	indexerServiceServiceDescriptor     = v1.File_indexer_v1_indexer_proto.Services().ByName("IndexerService")
-	indexerServiceIndexMethodDescriptor = indexerServiceServiceDescriptor.Methods().ByName("Index")
+	indexerServiceServiceDescriptor                         = v1.File_indexer_v1_indexer_proto.Services().ByName("IndexerService")
+	indexerServiceIndexMethodDescriptor                     = indexerServiceServiceDescriptor.Methods().ByName("Index")
+	indexerServiceListUrlsMethodDescriptor                  = indexerServiceServiceDescriptor.Methods().ByName("ListUrls")
+	indexerServiceDeletePageMethodDescriptor                = indexerServiceServiceDescriptor.Methods().ByName("DeletePage")
+	indexerServiceDeleteByPrefixMethodDescriptor            = indexerServiceServiceDescriptor.Methods().ByName("DeleteByPrefix")
+	indexerServicePurgeMethodDescriptor                     = indexerServiceServiceDescriptor.Methods().ByName("Purge")
+	indexerServiceSearchMethodDescriptor                    = indexerServiceServiceDescriptor.Methods().ByName("Search")
+	indexerServiceListCollectionVersionsMethodDescriptor    = indexerServiceServiceDescriptor.Methods().ByName("ListCollectionVersions")
+	indexerServiceCreateCollectionVersionMethodDescriptor   = indexerServiceServiceDescriptor.Methods().ByName("CreateCollectionVersion")
+	indexerServiceActivateCollectionVersionMethodDescriptor = indexerServiceServiceDescriptor.Methods().ByName("ActivateCollectionVersion")
+	indexerServiceRollbackCollectionVersionMethodDescriptor = indexerServiceServiceDescriptor.Methods().ByName("RollbackCollectionVersion")
+	indexerServiceDeleteCollectionVersionMethodDescriptor   = indexerServiceServiceDescriptor.Methods().ByName("DeleteCollectionVersion")
+	indexerServiceWarmEmbeddingCacheMethodDescriptor        = indexerServiceServiceDescriptor.Methods().ByName("WarmEmbeddingCache")
 )
 
 // IndexerServiceClient is a client for the indexer.v1.IndexerService service.
 type IndexerServiceClient interface {
 	Index(context.Context, *connect.Request[v1.IndexRequest]) (*connect.Response[v1.IndexResponse], error)
+	ListUrls(context.Context, *connect.Request[v1.ListUrlsRequest]) (*connect.Response[v1.ListUrlsResponse], error)
+	// DeletePage removes the page point and the section points of a page.
+	DeletePage(context.Context, *connect.Request[v1.DeletePageRequest]) (*connect.Response[v1.DeletePageResponse], error)
+	// DeleteByPrefix removes every page whose URL starts with prefix.
+	DeleteByPrefix(context.Context, *connect.Request[v1.DeleteByPrefixRequest]) (*connect.Response[v1.DeleteByPrefixResponse], error)
+	// Purge removes every point of the collection, keeping the collection.
+	Purge(context.Context, *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error)
+	// Search finds the pages and sections matching a query.
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+	// ListCollectionVersions lists the versioned collections behind the
+	// collection alias and which one the alias points to.
+	ListCollectionVersions(context.Context, *connect.Request[v1.ListCollectionVersionsRequest]) (*connect.Response[v1.ListCollectionVersionsResponse], error)
+	// CreateCollectionVersion creates the next versioned collection from the
+	// configured schema without switching the alias to it.
+	CreateCollectionVersion(context.Context, *connect.Request[v1.CreateCollectionVersionRequest]) (*connect.Response[v1.CreateCollectionVersionResponse], error)
+	// ActivateCollectionVersion atomically points the alias at a version.
+	ActivateCollectionVersion(context.Context, *connect.Request[v1.ActivateCollectionVersionRequest]) (*connect.Response[v1.ActivateCollectionVersionResponse], error)
+	// RollbackCollectionVersion points the alias back at the newest version
+	// older than the active one.
+	RollbackCollectionVersion(context.Context, *connect.Request[v1.RollbackCollectionVersionRequest]) (*connect.Response[v1.RollbackCollectionVersionResponse], error)
+	// DeleteCollectionVersion drops a version that is not active.
+	DeleteCollectionVersion(context.Context, *connect.Request[v1.DeleteCollectionVersionRequest]) (*connect.Response[v1.DeleteCollectionVersionResponse], error)
+	// WarmEmbeddingCache fills the embedding cache with the vectors stored in
+	// a collection, so indexing the same content again doesn't embed it.
+	WarmEmbeddingCache(context.Context, *connect.Request[v1.WarmEmbeddingCacheRequest]) (*connect.Response[v1.WarmEmbeddingCacheResponse], error)
 }
 
 // NewIndexerServiceClient constructs a client for the indexer.v1.IndexerService service. By
@@ -64,12 +130,89 @@ func NewIndexerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(indexerServiceIndexMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listUrls: connect.NewClient[v1.ListUrlsRequest, v1.ListUrlsResponse](
+			httpClient,
+			baseURL+IndexerServiceListUrlsProcedure,
+			connect.WithSchema(indexerServiceListUrlsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deletePage: connect.NewClient[v1.DeletePageRequest, v1.DeletePageResponse](
+			httpClient,
+			baseURL+IndexerServiceDeletePageProcedure,
+			connect.WithSchema(indexerServiceDeletePageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteByPrefix: connect.NewClient[v1.DeleteByPrefixRequest, v1.DeleteByPrefixResponse](
+			httpClient,
+			baseURL+IndexerServiceDeleteByPrefixProcedure,
+			connect.WithSchema(indexerServiceDeleteByPrefixMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		purge: connect.NewClient[v1.PurgeRequest, v1.PurgeResponse](
+			httpClient,
+			baseURL+IndexerServicePurgeProcedure,
+			connect.WithSchema(indexerServicePurgeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		search: connect.NewClient[v1.SearchRequest, v1.SearchResponse](
+			httpClient,
+			baseURL+IndexerServiceSearchProcedure,
+			connect.WithSchema(indexerServiceSearchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listCollectionVersions: connect.NewClient[v1.ListCollectionVersionsRequest, v1.ListCollectionVersionsResponse](
+			httpClient,
+			baseURL+IndexerServiceListCollectionVersionsProcedure,
+			connect.WithSchema(indexerServiceListCollectionVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createCollectionVersion: connect.NewClient[v1.CreateCollectionVersionRequest, v1.CreateCollectionVersionResponse](
+			httpClient,
+			baseURL+IndexerServiceCreateCollectionVersionProcedure,
+			connect.WithSchema(indexerServiceCreateCollectionVersionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		activateCollectionVersion: connect.NewClient[v1.ActivateCollectionVersionRequest, v1.ActivateCollectionVersionResponse](
+			httpClient,
+			baseURL+IndexerServiceActivateCollectionVersionProcedure,
+			connect.WithSchema(indexerServiceActivateCollectionVersionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackCollectionVersion: connect.NewClient[v1.RollbackCollectionVersionRequest, v1.RollbackCollectionVersionResponse](
+			httpClient,
+			baseURL+IndexerServiceRollbackCollectionVersionProcedure,
+			connect.WithSchema(indexerServiceRollbackCollectionVersionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCollectionVersion: connect.NewClient[v1.DeleteCollectionVersionRequest, v1.DeleteCollectionVersionResponse](
+			httpClient,
+			baseURL+IndexerServiceDeleteCollectionVersionProcedure,
+			connect.WithSchema(indexerServiceDeleteCollectionVersionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		warmEmbeddingCache: connect.NewClient[v1.WarmEmbeddingCacheRequest, v1.WarmEmbeddingCacheResponse](
+			httpClient,
+			baseURL+IndexerServiceWarmEmbeddingCacheProcedure,
+			connect.WithSchema(indexerServiceWarmEmbeddingCacheMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // indexerServiceClient implements IndexerServiceClient.
 type indexerServiceClient struct {
-	index *connect.Client[v1.IndexRequest, v1.IndexResponse]
+	index                     *connect.Client[v1.IndexRequest, v1.IndexResponse]
+	listUrls                  *connect.Client[v1.ListUrlsRequest, v1.ListUrlsResponse]
+	deletePage                *connect.Client[v1.DeletePageRequest, v1.DeletePageResponse]
+	deleteByPrefix            *connect.Client[v1.DeleteByPrefixRequest, v1.DeleteByPrefixResponse]
+	purge                     *connect.Client[v1.PurgeRequest, v1.PurgeResponse]
+	search                    *connect.Client[v1.SearchRequest, v1.SearchResponse]
+	listCollectionVersions    *connect.Client[v1.ListCollectionVersionsRequest, v1.ListCollectionVersionsResponse]
+	createCollectionVersion   *connect.Client[v1.CreateCollectionVersionRequest, v1.CreateCollectionVersionResponse]
+	activateCollectionVersion *connect.Client[v1.ActivateCollectionVersionRequest, v1.ActivateCollectionVersionResponse]
+	rollbackCollectionVersion *connect.Client[v1.RollbackCollectionVersionRequest, v1.RollbackCollectionVersionResponse]
+	deleteCollectionVersion   *connect.Client[v1.DeleteCollectionVersionRequest, v1.DeleteCollectionVersionResponse]
+	warmEmbeddingCache        *connect.Client[v1.WarmEmbeddingCacheRequest, v1.WarmEmbeddingCacheResponse]
 }
 
 // Index calls indexer.v1.IndexerService.Index.
@@ -77,9 +220,89 @@ func (c *indexerServiceClient) Index(ctx context.Context, req *connect.Request[v
 	return c.index.CallUnary(ctx, req)
 }
 
+// ListUrls calls indexer.v1.IndexerService.ListUrls.
+func (c *indexerServiceClient) ListUrls(ctx context.Context, req *connect.Request[v1.ListUrlsRequest]) (*connect.Response[v1.ListUrlsResponse], error) {
+	return c.listUrls.CallUnary(ctx, req)
+}
+
+// DeletePage calls indexer.v1.IndexerService.DeletePage.
+func (c *indexerServiceClient) DeletePage(ctx context.Context, req *connect.Request[v1.DeletePageRequest]) (*connect.Response[v1.DeletePageResponse], error) {
+	return c.deletePage.CallUnary(ctx, req)
+}
+
+// DeleteByPrefix calls indexer.v1.IndexerService.DeleteByPrefix.
+func (c *indexerServiceClient) DeleteByPrefix(ctx context.Context, req *connect.Request[v1.DeleteByPrefixRequest]) (*connect.Response[v1.DeleteByPrefixResponse], error) {
+	return c.deleteByPrefix.CallUnary(ctx, req)
+}
+
+// Purge calls indexer.v1.IndexerService.Purge.
+func (c *indexerServiceClient) Purge(ctx context.Context, req *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error) {
+	return c.purge.CallUnary(ctx, req)
+}
+
+// Search calls indexer.v1.IndexerService.Search.
+func (c *indexerServiceClient) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return c.search.CallUnary(ctx, req)
+}
+
+// ListCollectionVersions calls indexer.v1.IndexerService.ListCollectionVersions.
+func (c *indexerServiceClient) ListCollectionVersions(ctx context.Context, req *connect.Request[v1.ListCollectionVersionsRequest]) (*connect.Response[v1.ListCollectionVersionsResponse], error) {
+	return c.listCollectionVersions.CallUnary(ctx, req)
+}
+
+// CreateCollectionVersion calls indexer.v1.IndexerService.CreateCollectionVersion.
+func (c *indexerServiceClient) CreateCollectionVersion(ctx context.Context, req *connect.Request[v1.CreateCollectionVersionRequest]) (*connect.Response[v1.CreateCollectionVersionResponse], error) {
+	return c.createCollectionVersion.CallUnary(ctx, req)
+}
+
+// ActivateCollectionVersion calls indexer.v1.IndexerService.ActivateCollectionVersion.
+func (c *indexerServiceClient) ActivateCollectionVersion(ctx context.Context, req *connect.Request[v1.ActivateCollectionVersionRequest]) (*connect.Response[v1.ActivateCollectionVersionResponse], error) {
+	return c.activateCollectionVersion.CallUnary(ctx, req)
+}
+
+// RollbackCollectionVersion calls indexer.v1.IndexerService.RollbackCollectionVersion.
+func (c *indexerServiceClient) RollbackCollectionVersion(ctx context.Context, req *connect.Request[v1.RollbackCollectionVersionRequest]) (*connect.Response[v1.RollbackCollectionVersionResponse], error) {
+	return c.rollbackCollectionVersion.CallUnary(ctx, req)
+}
+
+// DeleteCollectionVersion calls indexer.v1.IndexerService.DeleteCollectionVersion.
+func (c *indexerServiceClient) DeleteCollectionVersion(ctx context.Context, req *connect.Request[v1.DeleteCollectionVersionRequest]) (*connect.Response[v1.DeleteCollectionVersionResponse], error) {
+	return c.deleteCollectionVersion.CallUnary(ctx, req)
+}
+
+// WarmEmbeddingCache calls indexer.v1.IndexerService.WarmEmbeddingCache.
+func (c *indexerServiceClient) WarmEmbeddingCache(ctx context.Context, req *connect.Request[v1.WarmEmbeddingCacheRequest]) (*connect.Response[v1.WarmEmbeddingCacheResponse], error) {
+	return c.warmEmbeddingCache.CallUnary(ctx, req)
+}
+
 // IndexerServiceHandler is an implementation of the indexer.v1.IndexerService service.
 type IndexerServiceHandler interface {
 	Index(context.Context, *connect.Request[v1.IndexRequest]) (*connect.Response[v1.IndexResponse], error)
+	ListUrls(context.Context, *connect.Request[v1.ListUrlsRequest]) (*connect.Response[v1.ListUrlsResponse], error)
+	// DeletePage removes the page point and the section points of a page.
+	DeletePage(context.Context, *connect.Request[v1.DeletePageRequest]) (*connect.Response[v1.DeletePageResponse], error)
+	// DeleteByPrefix removes every page whose URL starts with prefix.
+	DeleteByPrefix(context.Context, *connect.Request[v1.DeleteByPrefixRequest]) (*connect.Response[v1.DeleteByPrefixResponse], error)
+	// Purge removes every point of the collection, keeping the collection.
+	Purge(context.Context, *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error)
+	// Search finds the pages and sections matching a query.
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+	// ListCollectionVersions lists the versioned collections behind the
+	// collection alias and which one the alias points to.
+	ListCollectionVersions(context.Context, *connect.Request[v1.ListCollectionVersionsRequest]) (*connect.Response[v1.ListCollectionVersionsResponse], error)
+	// CreateCollectionVersion creates the next versioned collection from the
+	// configured schema without switching the alias to it.
+	CreateCollectionVersion(context.Context, *connect.Request[v1.CreateCollectionVersionRequest]) (*connect.Response[v1.CreateCollectionVersionResponse], error)
+	// ActivateCollectionVersion atomically points the alias at a version.
+	ActivateCollectionVersion(context.Context, *connect.Request[v1.ActivateCollectionVersionRequest]) (*connect.Response[v1.ActivateCollectionVersionResponse], error)
+	// RollbackCollectionVersion points the alias back at the newest version
+	// older than the active one.
+	RollbackCollectionVersion(context.Context, *connect.Request[v1.RollbackCollectionVersionRequest]) (*connect.Response[v1.RollbackCollectionVersionResponse], error)
+	// DeleteCollectionVersion drops a version that is not active.
+	DeleteCollectionVersion(context.Context, *connect.Request[v1.DeleteCollectionVersionRequest]) (*connect.Response[v1.DeleteCollectionVersionResponse], error)
+	// WarmEmbeddingCache fills the embedding cache with the vectors stored in
+	// a collection, so indexing the same content again doesn't embed it.
+	WarmEmbeddingCache(context.Context, *connect.Request[v1.WarmEmbeddingCacheRequest]) (*connect.Response[v1.WarmEmbeddingCacheResponse], error)
 }
 
 // NewIndexerServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -94,10 +317,98 @@ func NewIndexerServiceHandler(svc IndexerServiceHandler, opts ...connect.Handler
 		connect.WithSchema(indexerServiceIndexMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	indexerServiceListUrlsHandler := connect.NewUnaryHandler(
+		IndexerServiceListUrlsProcedure,
+		svc.ListUrls,
+		connect.WithSchema(indexerServiceListUrlsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceDeletePageHandler := connect.NewUnaryHandler(
+		IndexerServiceDeletePageProcedure,
+		svc.DeletePage,
+		connect.WithSchema(indexerServiceDeletePageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceDeleteByPrefixHandler := connect.NewUnaryHandler(
+		IndexerServiceDeleteByPrefixProcedure,
+		svc.DeleteByPrefix,
+		connect.WithSchema(indexerServiceDeleteByPrefixMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServicePurgeHandler := connect.NewUnaryHandler(
+		IndexerServicePurgeProcedure,
+		svc.Purge,
+		connect.WithSchema(indexerServicePurgeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceSearchHandler := connect.NewUnaryHandler(
+		IndexerServiceSearchProcedure,
+		svc.Search,
+		connect.WithSchema(indexerServiceSearchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceListCollectionVersionsHandler := connect.NewUnaryHandler(
+		IndexerServiceListCollectionVersionsProcedure,
+		svc.ListCollectionVersions,
+		connect.WithSchema(indexerServiceListCollectionVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceCreateCollectionVersionHandler := connect.NewUnaryHandler(
+		IndexerServiceCreateCollectionVersionProcedure,
+		svc.CreateCollectionVersion,
+		connect.WithSchema(indexerServiceCreateCollectionVersionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceActivateCollectionVersionHandler := connect.NewUnaryHandler(
+		IndexerServiceActivateCollectionVersionProcedure,
+		svc.ActivateCollectionVersion,
+		connect.WithSchema(indexerServiceActivateCollectionVersionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceRollbackCollectionVersionHandler := connect.NewUnaryHandler(
+		IndexerServiceRollbackCollectionVersionProcedure,
+		svc.RollbackCollectionVersion,
+		connect.WithSchema(indexerServiceRollbackCollectionVersionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceDeleteCollectionVersionHandler := connect.NewUnaryHandler(
+		IndexerServiceDeleteCollectionVersionProcedure,
+		svc.DeleteCollectionVersion,
+		connect.WithSchema(indexerServiceDeleteCollectionVersionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	indexerServiceWarmEmbeddingCacheHandler := connect.NewUnaryHandler(
+		IndexerServiceWarmEmbeddingCacheProcedure,
+		svc.WarmEmbeddingCache,
+		connect.WithSchema(indexerServiceWarmEmbeddingCacheMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/indexer.v1.IndexerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IndexerServiceIndexProcedure:
 			indexerServiceIndexHandler.ServeHTTP(w, r)
+		case IndexerServiceListUrlsProcedure:
+			indexerServiceListUrlsHandler.ServeHTTP(w, r)
+		case IndexerServiceDeletePageProcedure:
+			indexerServiceDeletePageHandler.ServeHTTP(w, r)
+		case IndexerServiceDeleteByPrefixProcedure:
+			indexerServiceDeleteByPrefixHandler.ServeHTTP(w, r)
+		case IndexerServicePurgeProcedure:
+			indexerServicePurgeHandler.ServeHTTP(w, r)
+		case IndexerServiceSearchProcedure:
+			indexerServiceSearchHandler.ServeHTTP(w, r)
+		case IndexerServiceListCollectionVersionsProcedure:
+			indexerServiceListCollectionVersionsHandler.ServeHTTP(w, r)
+		case IndexerServiceCreateCollectionVersionProcedure:
+			indexerServiceCreateCollectionVersionHandler.ServeHTTP(w, r)
+		case IndexerServiceActivateCollectionVersionProcedure:
+			indexerServiceActivateCollectionVersionHandler.ServeHTTP(w, r)
+		case IndexerServiceRollbackCollectionVersionProcedure:
+			indexerServiceRollbackCollectionVersionHandler.ServeHTTP(w, r)
+		case IndexerServiceDeleteCollectionVersionProcedure:
+			indexerServiceDeleteCollectionVersionHandler.ServeHTTP(w, r)
+		case IndexerServiceWarmEmbeddingCacheProcedure:
+			indexerServiceWarmEmbeddingCacheHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -110,3 +421,47 @@ type UnimplementedIndexerServiceHandler struct{}
 func (UnimplementedIndexerServiceHandler) Index(context.Context, *connect.Request[v1.IndexRequest]) (*connect.Response[v1.IndexResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.Index is not implemented"))
 }
+
+func (UnimplementedIndexerServiceHandler) ListUrls(context.Context, *connect.Request[v1.ListUrlsRequest]) (*connect.Response[v1.ListUrlsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.ListUrls is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) DeletePage(context.Context, *connect.Request[v1.DeletePageRequest]) (*connect.Response[v1.DeletePageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.DeletePage is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) DeleteByPrefix(context.Context, *connect.Request[v1.DeleteByPrefixRequest]) (*connect.Response[v1.DeleteByPrefixResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.DeleteByPrefix is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) Purge(context.Context, *connect.Request[v1.PurgeRequest]) (*connect.Response[v1.PurgeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.Purge is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.Search is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) ListCollectionVersions(context.Context, *connect.Request[v1.ListCollectionVersionsRequest]) (*connect.Response[v1.ListCollectionVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.ListCollectionVersions is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) CreateCollectionVersion(context.Context, *connect.Request[v1.CreateCollectionVersionRequest]) (*connect.Response[v1.CreateCollectionVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.CreateCollectionVersion is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) ActivateCollectionVersion(context.Context, *connect.Request[v1.ActivateCollectionVersionRequest]) (*connect.Response[v1.ActivateCollectionVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.ActivateCollectionVersion is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) RollbackCollectionVersion(context.Context, *connect.Request[v1.RollbackCollectionVersionRequest]) (*connect.Response[v1.RollbackCollectionVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.RollbackCollectionVersion is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) DeleteCollectionVersion(context.Context, *connect.Request[v1.DeleteCollectionVersionRequest]) (*connect.Response[v1.DeleteCollectionVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.DeleteCollectionVersion is not implemented"))
+}
+
+func (UnimplementedIndexerServiceHandler) WarmEmbeddingCache(context.Context, *connect.Request[v1.WarmEmbeddingCacheRequest]) (*connect.Response[v1.WarmEmbeddingCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("indexer.v1.IndexerService.WarmEmbeddingCache is not implemented"))
+}
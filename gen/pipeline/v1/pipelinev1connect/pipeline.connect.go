@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: pipeline/v1/pipeline.proto
+
+package pipelinev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/aiocean/shopify-doc-extractor/gen/pipeline/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PipelineServiceName is the fully-qualified name of the PipelineService service.
+	PipelineServiceName = "pipeline.v1.PipelineService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PipelineServiceExtractAndIndexProcedure is the fully-qualified name of the PipelineService's
+	// ExtractAndIndex RPC.
+	PipelineServiceExtractAndIndexProcedure = "/pipeline.v1.PipelineService/ExtractAndIndex"
+	// PipelineServiceRebuildProcedure is the fully-qualified name of the PipelineService's Rebuild RPC.
+	PipelineServiceRebuildProcedure = "/pipeline.v1.PipelineService/Rebuild"
+	// PipelineServiceGetRebuildProcedure is the fully-qualified name of the PipelineService's
+	// GetRebuild RPC.
+	PipelineServiceGetRebuildProcedure = "/pipeline.v1.PipelineService/GetRebuild"
+	// PipelineServiceGenerateLlmsTxtProcedure is the fully-qualified name of the PipelineService's
+	// GenerateLlmsTxt RPC.
+	PipelineServiceGenerateLlmsTxtProcedure = "/pipeline.v1.PipelineService/GenerateLlmsTxt"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	pipelineServiceServiceDescriptor               = v1.File_pipeline_v1_pipeline_proto.Services().ByName("PipelineService")
+	pipelineServiceExtractAndIndexMethodDescriptor = pipelineServiceServiceDescriptor.Methods().ByName("ExtractAndIndex")
+	pipelineServiceRebuildMethodDescriptor         = pipelineServiceServiceDescriptor.Methods().ByName("Rebuild")
+	pipelineServiceGetRebuildMethodDescriptor      = pipelineServiceServiceDescriptor.Methods().ByName("GetRebuild")
+	pipelineServiceGenerateLlmsTxtMethodDescriptor = pipelineServiceServiceDescriptor.Methods().ByName("GenerateLlmsTxt")
+)
+
+// PipelineServiceClient is a client for the pipeline.v1.PipelineService service.
+type PipelineServiceClient interface {
+	// ExtractAndIndex extracts each url and indexes the resulting page
+	// in-process.
+	ExtractAndIndex(context.Context, *connect.Request[v1.ExtractAndIndexRequest]) (*connect.Response[v1.ExtractAndIndexResponse], error)
+	// Rebuild starts a full reindex in the background: every page of the
+	// active collection is extracted again and indexed into a new collection
+	// version, which the alias is switched to once every page succeeded.
+	Rebuild(context.Context, *connect.Request[v1.RebuildRequest]) (*connect.Response[v1.RebuildResponse], error)
+	// GetRebuild reports the progress of a rebuild.
+	GetRebuild(context.Context, *connect.Request[v1.GetRebuildRequest]) (*connect.Response[v1.GetRebuildResponse], error)
+	// GenerateLlmsTxt renders an llms.txt index and an llms-full.txt
+	// concatenation of the indexed pages, or of the given pages.
+	GenerateLlmsTxt(context.Context, *connect.Request[v1.GenerateLlmsTxtRequest]) (*connect.Response[v1.GenerateLlmsTxtResponse], error)
+}
+
+// NewPipelineServiceClient constructs a client for the pipeline.v1.PipelineService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPipelineServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PipelineServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &pipelineServiceClient{
+		extractAndIndex: connect.NewClient[v1.ExtractAndIndexRequest, v1.ExtractAndIndexResponse](
+			httpClient,
+			baseURL+PipelineServiceExtractAndIndexProcedure,
+			connect.WithSchema(pipelineServiceExtractAndIndexMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rebuild: connect.NewClient[v1.RebuildRequest, v1.RebuildResponse](
+			httpClient,
+			baseURL+PipelineServiceRebuildProcedure,
+			connect.WithSchema(pipelineServiceRebuildMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRebuild: connect.NewClient[v1.GetRebuildRequest, v1.GetRebuildResponse](
+			httpClient,
+			baseURL+PipelineServiceGetRebuildProcedure,
+			connect.WithSchema(pipelineServiceGetRebuildMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		generateLlmsTxt: connect.NewClient[v1.GenerateLlmsTxtRequest, v1.GenerateLlmsTxtResponse](
+			httpClient,
+			baseURL+PipelineServiceGenerateLlmsTxtProcedure,
+			connect.WithSchema(pipelineServiceGenerateLlmsTxtMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// pipelineServiceClient implements PipelineServiceClient.
+type pipelineServiceClient struct {
+	extractAndIndex *connect.Client[v1.ExtractAndIndexRequest, v1.ExtractAndIndexResponse]
+	rebuild         *connect.Client[v1.RebuildRequest, v1.RebuildResponse]
+	getRebuild      *connect.Client[v1.GetRebuildRequest, v1.GetRebuildResponse]
+	generateLlmsTxt *connect.Client[v1.GenerateLlmsTxtRequest, v1.GenerateLlmsTxtResponse]
+}
+
+// ExtractAndIndex calls pipeline.v1.PipelineService.ExtractAndIndex.
+func (c *pipelineServiceClient) ExtractAndIndex(ctx context.Context, req *connect.Request[v1.ExtractAndIndexRequest]) (*connect.Response[v1.ExtractAndIndexResponse], error) {
+	return c.extractAndIndex.CallUnary(ctx, req)
+}
+
+// Rebuild calls pipeline.v1.PipelineService.Rebuild.
+func (c *pipelineServiceClient) Rebuild(ctx context.Context, req *connect.Request[v1.RebuildRequest]) (*connect.Response[v1.RebuildResponse], error) {
+	return c.rebuild.CallUnary(ctx, req)
+}
+
+// GetRebuild calls pipeline.v1.PipelineService.GetRebuild.
+func (c *pipelineServiceClient) GetRebuild(ctx context.Context, req *connect.Request[v1.GetRebuildRequest]) (*connect.Response[v1.GetRebuildResponse], error) {
+	return c.getRebuild.CallUnary(ctx, req)
+}
+
+// GenerateLlmsTxt calls pipeline.v1.PipelineService.GenerateLlmsTxt.
+func (c *pipelineServiceClient) GenerateLlmsTxt(ctx context.Context, req *connect.Request[v1.GenerateLlmsTxtRequest]) (*connect.Response[v1.GenerateLlmsTxtResponse], error) {
+	return c.generateLlmsTxt.CallUnary(ctx, req)
+}
+
+// PipelineServiceHandler is an implementation of the pipeline.v1.PipelineService service.
+type PipelineServiceHandler interface {
+	// ExtractAndIndex extracts each url and indexes the resulting page
+	// in-process.
+	ExtractAndIndex(context.Context, *connect.Request[v1.ExtractAndIndexRequest]) (*connect.Response[v1.ExtractAndIndexResponse], error)
+	// Rebuild starts a full reindex in the background: every page of the
+	// active collection is extracted again and indexed into a new collection
+	// version, which the alias is switched to once every page succeeded.
+	Rebuild(context.Context, *connect.Request[v1.RebuildRequest]) (*connect.Response[v1.RebuildResponse], error)
+	// GetRebuild reports the progress of a rebuild.
+	GetRebuild(context.Context, *connect.Request[v1.GetRebuildRequest]) (*connect.Response[v1.GetRebuildResponse], error)
+	// GenerateLlmsTxt renders an llms.txt index and an llms-full.txt
+	// concatenation of the indexed pages, or of the given pages.
+	GenerateLlmsTxt(context.Context, *connect.Request[v1.GenerateLlmsTxtRequest]) (*connect.Response[v1.GenerateLlmsTxtResponse], error)
+}
+
+// NewPipelineServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPipelineServiceHandler(svc PipelineServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	pipelineServiceExtractAndIndexHandler := connect.NewUnaryHandler(
+		PipelineServiceExtractAndIndexProcedure,
+		svc.ExtractAndIndex,
+		connect.WithSchema(pipelineServiceExtractAndIndexMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceRebuildHandler := connect.NewUnaryHandler(
+		PipelineServiceRebuildProcedure,
+		svc.Rebuild,
+		connect.WithSchema(pipelineServiceRebuildMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceGetRebuildHandler := connect.NewUnaryHandler(
+		PipelineServiceGetRebuildProcedure,
+		svc.GetRebuild,
+		connect.WithSchema(pipelineServiceGetRebuildMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceGenerateLlmsTxtHandler := connect.NewUnaryHandler(
+		PipelineServiceGenerateLlmsTxtProcedure,
+		svc.GenerateLlmsTxt,
+		connect.WithSchema(pipelineServiceGenerateLlmsTxtMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/pipeline.v1.PipelineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PipelineServiceExtractAndIndexProcedure:
+			pipelineServiceExtractAndIndexHandler.ServeHTTP(w, r)
+		case PipelineServiceRebuildProcedure:
+			pipelineServiceRebuildHandler.ServeHTTP(w, r)
+		case PipelineServiceGetRebuildProcedure:
+			pipelineServiceGetRebuildHandler.ServeHTTP(w, r)
+		case PipelineServiceGenerateLlmsTxtProcedure:
+			pipelineServiceGenerateLlmsTxtHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPipelineServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPipelineServiceHandler struct{}
+
+func (UnimplementedPipelineServiceHandler) ExtractAndIndex(context.Context, *connect.Request[v1.ExtractAndIndexRequest]) (*connect.Response[v1.ExtractAndIndexResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pipeline.v1.PipelineService.ExtractAndIndex is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) Rebuild(context.Context, *connect.Request[v1.RebuildRequest]) (*connect.Response[v1.RebuildResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pipeline.v1.PipelineService.Rebuild is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) GetRebuild(context.Context, *connect.Request[v1.GetRebuildRequest]) (*connect.Response[v1.GetRebuildResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pipeline.v1.PipelineService.GetRebuild is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) GenerateLlmsTxt(context.Context, *connect.Request[v1.GenerateLlmsTxtRequest]) (*connect.Response[v1.GenerateLlmsTxtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pipeline.v1.PipelineService.GenerateLlmsTxt is not implemented"))
+}
@@ -0,0 +1,66 @@
+// Package export writes the indexed pages to files other tools can read: JSON
+// Lines of DocPage records, a tree of Markdown files with front matter, or a
+// Parquet table.
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+)
+
+// Format is an export file format.
+type Format string
+
+const (
+	FormatJSONL    Format = "jsonl"
+	FormatMarkdown Format = "markdown"
+	FormatParquet  Format = "parquet"
+)
+
+// Writer writes exported pages. Close must be called once every page was
+// written, and the output is incomplete until it returns.
+type Writer interface {
+	Write(page *indexer.StoredPage) error
+	Close() error
+}
+
+// NewWriter returns a writer of format. JSONL and Parquet are written to the
+// file out, Markdown to the directory out.
+func NewWriter(format Format, out string) (Writer, error) {
+	switch format {
+	case FormatJSONL:
+		return NewJSONLWriter(out)
+	case FormatMarkdown:
+		return NewMarkdownWriter(out)
+	case FormatParquet:
+		return NewParquetWriter(out)
+	default:
+		return nil, fmt.Errorf("unknown export format %q, expected jsonl, markdown or parquet", format)
+	}
+}
+
+// Export writes the indexed pages starting with prefix and matching the
+// filter expression to w, and closes it. It returns the number of pages
+// written.
+func Export(ctx context.Context, w Writer, prefix string, filterExpression string) (int, error) {
+	pages, err := indexer.ListStoredPages(ctx, prefix, filterExpression)
+	if err != nil {
+		w.Close()
+		return 0, fmt.Errorf("failed to list pages: %w", err)
+	}
+
+	for _, page := range pages {
+		if err := w.Write(page); err != nil {
+			w.Close()
+			return 0, fmt.Errorf("failed to export %s: %w", page.DocPage.SourceUrl, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return 0, err
+	}
+
+	return len(pages), nil
+}
@@ -0,0 +1,212 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+	"github.com/xitongsys/parquet-go-source/local"
+	parquetreader "github.com/xitongsys/parquet-go/reader"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var testPages = []*indexer.StoredPage{
+	{
+		DocPage: &extractorv1.DocPage{
+			SourceTitle:     "Billing",
+			SourceUrl:       "/docs/apps/launch/billing",
+			ContentMarkdown: "# Billing\n\nCharge merchants.",
+			DocSections: []*extractorv1.DocSection{{
+				SectionTitle:    "Subscriptions",
+				Order:           1,
+				SourceTitle:     "Billing",
+				SourceUrl:       "/docs/apps/launch/billing",
+				SectionAnchor:   "#subscriptions",
+				ContentMarkdown: "Recurring charges.",
+			}},
+		},
+		Breadcrumbs: []string{"apps", "launch"},
+		ContentType: "guide",
+		IndexedAt:   "2024-10-01T00:00:00Z",
+	},
+	{
+		DocPage:     &extractorv1.DocPage{SourceTitle: "Home", SourceUrl: "/"},
+		ContentType: "other",
+	},
+}
+
+func writeAll(t *testing.T, w Writer) {
+	t.Helper()
+	for _, page := range testPages {
+		if err := w.Write(page); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pages.jsonl")
+	w, err := NewWriter(FormatJSONL, path)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	writeAll(t, w)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) != len(testPages) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(testPages))
+	}
+
+	page := &extractorv1.DocPage{}
+	if err := protojson.Unmarshal([]byte(lines[0]), page); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !proto.Equal(page, testPages[0].DocPage) || !strings.Contains(lines[0], `"doc_sections"`) {
+		t.Errorf("got %s", lines[0])
+	}
+}
+
+func TestMarkdownWriter(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(FormatMarkdown, dir)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	writeAll(t, w)
+
+	data, err := os.ReadFile(filepath.Join(dir, "docs", "apps", "launch", "billing.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `---
+title: "Billing"
+source_url: "/docs/apps/launch/billing"
+breadcrumbs: ["apps","launch"]
+api_version: ""
+content_type: "guide"
+indexed_at: "2024-10-01T00:00:00Z"
+sections: [{"anchor":"#subscriptions","title":"Subscriptions"}]
+---
+
+# Billing
+
+Charge merchants.
+
+## Subscriptions
+
+Recurring charges.
+`
+	if string(data) != want {
+		t.Errorf("got\n%s\nwant\n%s", data, want)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "index.md")); err != nil {
+		t.Errorf("the root page was not written to index.md: %v", err)
+	}
+}
+
+func TestMarkdownPath(t *testing.T) {
+	tests := map[string]string{
+		"/docs/api/admin-graphql/2024-10/mutations/productCreate": "docs/api/admin-graphql/2024-10/mutations/productCreate.md",
+		"https://shopify.dev/docs/apps?tab=1#top":                 "docs/apps.md",
+		"/docs/../../etc/passwd":                                  "etc/passwd.md",
+		"https://example.com/guide":                               "example.com/guide.md",
+	}
+	for pageUrl, want := range tests {
+		got, err := markdownPath(pageUrl)
+		if err != nil || got != filepath.FromSlash(want) {
+			t.Errorf("markdownPath(%q) = %q, %v, want %q", pageUrl, got, err, want)
+		}
+	}
+}
+
+// parquetRow is a row of the Parquet export, read back with an independent
+// Parquet reader.
+type parquetRow struct {
+	SourceUrl       string `parquet:"name=source_url, type=BYTE_ARRAY, convertedtype=UTF8"`
+	SourceTitle     string `parquet:"name=source_title, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ContentMarkdown string `parquet:"name=content_markdown, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Breadcrumbs     string `parquet:"name=breadcrumbs, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ApiVersion      string `parquet:"name=api_version, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ContentType     string `parquet:"name=content_type, type=BYTE_ARRAY, convertedtype=UTF8"`
+	IndexedAt       string `parquet:"name=indexed_at, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Sections        string `parquet:"name=sections, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+func TestParquetWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pages.parquet")
+	w, err := NewWriter(FormatParquet, path)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	// Write more pages than fit in a row group, so the file has two.
+	for i := 0; i < parquetRowGroupSize; i++ {
+		if err := w.Write(testPages[1]); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	writeAll(t, w)
+
+	file, err := local.NewLocalFileReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	reader, err := parquetreader.NewParquetReader(file, new(parquetRow), 1)
+	if err != nil {
+		t.Fatalf("NewParquetReader: %v", err)
+	}
+	defer reader.ReadStop()
+
+	if got, want := len(reader.Footer.RowGroups), 2; got != want {
+		t.Errorf("got %d row groups, want %d", got, want)
+	}
+	numRows := int(reader.GetNumRows())
+	if want := parquetRowGroupSize + len(testPages); numRows != want {
+		t.Fatalf("got %d rows, want %d", numRows, want)
+	}
+
+	rows := make([]parquetRow, numRows)
+	if err := reader.Read(&rows); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	want := []parquetRow{
+		{
+			SourceUrl:       "/docs/apps/launch/billing",
+			SourceTitle:     "Billing",
+			ContentMarkdown: "# Billing\n\nCharge merchants.",
+			Breadcrumbs:     `["apps","launch"]`,
+			ContentType:     "guide",
+			IndexedAt:       "2024-10-01T00:00:00Z",
+			Sections:        `[{"title":"Subscriptions","anchor":"#subscriptions","order":1,"content":"Recurring charges."}]`,
+		},
+		{
+			SourceUrl:   "/",
+			SourceTitle: "Home",
+			Breadcrumbs: "[]",
+			ContentType: "other",
+			Sections:    "[]",
+		},
+	}
+	if rows[0] != want[1] {
+		t.Errorf("got first row %+v, want %+v", rows[0], want[1])
+	}
+	for i, row := range rows[parquetRowGroupSize:] {
+		if row != want[i] {
+			t.Errorf("got row %+v, want %+v", row, want[i])
+		}
+	}
+}
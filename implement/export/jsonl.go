@@ -0,0 +1,46 @@
+package export
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// JSONLWriter writes one DocPage per line, as protobuf JSON with the field
+// names of the proto file.
+type JSONLWriter struct {
+	file *os.File
+	buf  *bufio.Writer
+}
+
+func NewJSONLWriter(path string) (*JSONLWriter, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONLWriter{file: file, buf: bufio.NewWriter(file)}, nil
+}
+
+func (w *JSONLWriter) Write(page *indexer.StoredPage) error {
+	line, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(page.DocPage)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.buf.Write(line); err != nil {
+		return err
+	}
+	return w.buf.WriteByte('\n')
+}
+
+func (w *JSONLWriter) Close() error {
+	if err := w.buf.Flush(); err != nil {
+		w.file.Close()
+		return err
+	}
+
+	return w.file.Close()
+}
@@ -0,0 +1,110 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aiocean/shopify-doc-extractor/implement/extractor"
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+)
+
+// MarkdownWriter mirrors the URL tree of the pages in a directory: the page
+// /docs/apps/launch/billing is written to docs/apps/launch/billing.md, with
+// its sections under their headings and its metadata as YAML front matter.
+type MarkdownWriter struct {
+	dir string
+}
+
+func NewMarkdownWriter(dir string) (*MarkdownWriter, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &MarkdownWriter{dir: dir}, nil
+}
+
+// markdownPath returns the file of a page URL relative to the export
+// directory.
+func markdownPath(pageUrl string) (string, error) {
+	parsed, err := url.Parse(pageUrl)
+	if err != nil {
+		return "", err
+	}
+
+	// Cleaning a rooted path removes any "..".
+	cleaned := strings.TrimPrefix(path.Clean("/"+parsed.Path), "/")
+	if cleaned == "" {
+		cleaned = "index"
+	}
+	if parsed.Host != "" && parsed.Host != "shopify.dev" {
+		cleaned = path.Join(parsed.Host, cleaned)
+	}
+
+	return filepath.FromSlash(cleaned) + ".md", nil
+}
+
+// frontMatter renders the metadata of a page as YAML. Values are written as
+// JSON, which YAML parsers read as is.
+func frontMatter(page *indexer.StoredPage) (string, error) {
+	sections := []map[string]any{}
+	for _, section := range page.DocPage.DocSections {
+		sections = append(sections, map[string]any{
+			"title":  section.SectionTitle,
+			"anchor": section.SectionAnchor,
+		})
+	}
+
+	fields := []struct {
+		name  string
+		value any
+	}{
+		{"title", page.DocPage.SourceTitle},
+		{"source_url", page.DocPage.SourceUrl},
+		{"breadcrumbs", append([]string{}, page.Breadcrumbs...)},
+		{"api_version", page.ApiVersion},
+		{"content_type", page.ContentType},
+		{"indexed_at", page.IndexedAt},
+		{"sections", sections},
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	for _, field := range fields {
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "%s: %s\n", field.name, value)
+	}
+	b.WriteString("---\n\n")
+
+	return b.String(), nil
+}
+
+func (w *MarkdownWriter) Write(page *indexer.StoredPage) error {
+	relative, err := markdownPath(page.DocPage.SourceUrl)
+	if err != nil {
+		return err
+	}
+
+	header, err := frontMatter(page)
+	if err != nil {
+		return err
+	}
+
+	fullPath := filepath.Join(w.dir, relative)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(fullPath, []byte(header+extractor.PageMarkdown(page.DocPage)+"\n"), 0o644)
+}
+
+func (w *MarkdownWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,329 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+)
+
+// parquetColumns are the columns of the Parquet export, all required UTF-8
+// strings. breadcrumbs and sections hold JSON arrays.
+var parquetColumns = []string{
+	"source_url",
+	"source_title",
+	"content_markdown",
+	"breadcrumbs",
+	"api_version",
+	"content_type",
+	"indexed_at",
+	"sections",
+}
+
+// parquetRowGroupSize is how many pages are buffered before they are written
+// as a row group.
+const parquetRowGroupSize = 1000
+
+type parquetSection struct {
+	Title   string `json:"title"`
+	Anchor  string `json:"anchor"`
+	Order   int32  `json:"order"`
+	Content string `json:"content"`
+}
+
+// ParquetWriter writes pages as a Parquet table with one row per page.
+//
+// The file is written directly rather than with a Parquet library: the
+// schema is flat, with required byte array columns that are plain encoded
+// and uncompressed, which every Parquet reader supports. The tests read the
+// files back with github.com/xitongsys/parquet-go.
+type ParquetWriter struct {
+	file   *os.File
+	buf    *bufio.Writer
+	offset int64
+
+	// rows buffers the values of the current row group, by column.
+	rows      [][]string
+	rowGroups thriftList
+	numRows   int64
+}
+
+func NewParquetWriter(path string) (*ParquetWriter, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &ParquetWriter{
+		file: file,
+		buf:  bufio.NewWriter(file),
+		rows: make([][]string, len(parquetColumns)),
+	}
+	if err := w.write([]byte("PAR1")); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *ParquetWriter) write(data []byte) error {
+	n, err := w.buf.Write(data)
+	w.offset += int64(n)
+	return err
+}
+
+func (w *ParquetWriter) Write(page *indexer.StoredPage) error {
+	sections := []parquetSection{}
+	for _, section := range page.DocPage.DocSections {
+		sections = append(sections, parquetSection{
+			Title:   section.SectionTitle,
+			Anchor:  section.SectionAnchor,
+			Order:   section.Order,
+			Content: section.ContentMarkdown,
+		})
+	}
+	sectionsJSON, err := json.Marshal(sections)
+	if err != nil {
+		return err
+	}
+	breadcrumbsJSON, err := json.Marshal(append([]string{}, page.Breadcrumbs...))
+	if err != nil {
+		return err
+	}
+
+	row := []string{
+		page.DocPage.SourceUrl,
+		page.DocPage.SourceTitle,
+		page.DocPage.ContentMarkdown,
+		string(breadcrumbsJSON),
+		page.ApiVersion,
+		page.ContentType,
+		page.IndexedAt,
+		string(sectionsJSON),
+	}
+	for i, value := range row {
+		w.rows[i] = append(w.rows[i], value)
+	}
+
+	if len(w.rows[0]) >= parquetRowGroupSize {
+		return w.flushRowGroup()
+	}
+	return nil
+}
+
+// flushRowGroup writes the buffered rows as a row group with one data page
+// per column.
+func (w *ParquetWriter) flushRowGroup() error {
+	numRows := len(w.rows[0])
+	if numRows == 0 {
+		return nil
+	}
+
+	var columns thriftList
+	var totalSize int64
+	for i, name := range parquetColumns {
+		var data bytes.Buffer
+		for _, value := range w.rows[i] {
+			data.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(value))))
+			data.WriteString(value)
+		}
+
+		header := encodeThrift(thriftStruct{
+			{1, int32(parquetPageTypeDataPage)},
+			{2, int32(data.Len())},
+			{3, int32(data.Len())},
+			{5, thriftStruct{
+				{1, int32(numRows)},
+				{2, int32(parquetEncodingPlain)},
+				{3, int32(parquetEncodingRLE)},
+				{4, int32(parquetEncodingRLE)},
+			}},
+		})
+
+		pageOffset := w.offset
+		if err := w.write(header); err != nil {
+			return err
+		}
+		if err := w.write(data.Bytes()); err != nil {
+			return err
+		}
+		size := int64(len(header) + data.Len())
+		totalSize += size
+
+		columns = append(columns, thriftStruct{
+			{2, pageOffset},
+			{3, thriftStruct{
+				{1, int32(parquetTypeByteArray)},
+				{2, thriftList{int32(parquetEncodingPlain), int32(parquetEncodingRLE)}},
+				{3, thriftList{name}},
+				{4, int32(parquetCodecUncompressed)},
+				{5, int64(numRows)},
+				{6, size},
+				{7, size},
+				{9, pageOffset},
+			}},
+		})
+
+		w.rows[i] = w.rows[i][:0]
+	}
+
+	w.rowGroups = append(w.rowGroups, thriftStruct{
+		{1, columns},
+		{2, totalSize},
+		{3, int64(numRows)},
+	})
+	w.numRows += int64(numRows)
+
+	return nil
+}
+
+// Close writes the last row group and the file footer.
+func (w *ParquetWriter) Close() error {
+	err := w.close()
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (w *ParquetWriter) close() error {
+	if err := w.flushRowGroup(); err != nil {
+		return err
+	}
+
+	schema := thriftList{thriftStruct{
+		{4, "schema"},
+		{5, int32(len(parquetColumns))},
+	}}
+	for _, name := range parquetColumns {
+		schema = append(schema, thriftStruct{
+			{1, int32(parquetTypeByteArray)},
+			{3, int32(parquetRepetitionRequired)},
+			{4, name},
+			{6, int32(parquetConvertedTypeUTF8)},
+			// LogicalType STRING.
+			{10, thriftStruct{{1, thriftStruct{}}}},
+		})
+	}
+
+	metadata := thriftStruct{
+		{1, int32(1)},
+		{2, schema},
+		{3, w.numRows},
+		{4, w.rowGroups},
+		{6, "shopify-doc-extractor"},
+	}
+	footer := encodeThrift(metadata)
+	footer = binary.LittleEndian.AppendUint32(footer, uint32(len(footer)))
+	footer = append(footer, "PAR1"...)
+	if err := w.write(footer); err != nil {
+		return err
+	}
+
+	return w.buf.Flush()
+}
+
+// Parquet enum values, from parquet.thrift.
+const (
+	parquetTypeByteArray      = 6
+	parquetRepetitionRequired = 0
+	parquetConvertedTypeUTF8  = 0
+	parquetEncodingPlain      = 0
+	parquetEncodingRLE        = 3
+	parquetCodecUncompressed  = 0
+	parquetPageTypeDataPage   = 0
+)
+
+// Parquet metadata is serialized with the Thrift compact protocol. The
+// values used are int32, int64, string, thriftStruct and thriftList.
+type thriftField struct {
+	id    int16
+	value any
+}
+
+// thriftStruct lists the set fields of a struct by increasing id.
+type thriftStruct []thriftField
+
+// thriftList is a list of values of the same type.
+type thriftList []any
+
+const (
+	thriftTypeI32    = 5
+	thriftTypeI64    = 6
+	thriftTypeBinary = 8
+	thriftTypeList   = 9
+	thriftTypeStruct = 12
+)
+
+func thriftType(value any) byte {
+	switch value.(type) {
+	case int32:
+		return thriftTypeI32
+	case int64:
+		return thriftTypeI64
+	case string:
+		return thriftTypeBinary
+	case thriftList:
+		return thriftTypeList
+	default:
+		return thriftTypeStruct
+	}
+}
+
+func encodeThrift(s thriftStruct) []byte {
+	var buf bytes.Buffer
+	writeThriftValue(&buf, s)
+	return buf.Bytes()
+}
+
+func writeThriftValue(buf *bytes.Buffer, value any) {
+	switch value := value.(type) {
+	case int32:
+		writeVarint(buf, zigzag(int64(value)))
+	case int64:
+		writeVarint(buf, zigzag(value))
+	case string:
+		writeVarint(buf, uint64(len(value)))
+		buf.WriteString(value)
+	case thriftList:
+		elementType := byte(thriftTypeStruct)
+		if len(value) > 0 {
+			elementType = thriftType(value[0])
+		}
+		if len(value) < 15 {
+			buf.WriteByte(byte(len(value))<<4 | elementType)
+		} else {
+			buf.WriteByte(0xf0 | elementType)
+			writeVarint(buf, uint64(len(value)))
+		}
+		for _, element := range value {
+			writeThriftValue(buf, element)
+		}
+	case thriftStruct:
+		var lastID int16
+		for _, field := range value {
+			fieldType := thriftType(field.value)
+			if delta := field.id - lastID; delta > 0 && delta <= 15 {
+				buf.WriteByte(byte(delta)<<4 | fieldType)
+			} else {
+				buf.WriteByte(fieldType)
+				writeVarint(buf, zigzag(int64(field.id)))
+			}
+			lastID = field.id
+			writeThriftValue(buf, field.value)
+		}
+		buf.WriteByte(0)
+	}
+}
+
+func zigzag(n int64) uint64 {
+	return uint64(n<<1) ^ uint64(n>>63)
+}
+
+func writeVarint(buf *bytes.Buffer, n uint64) {
+	buf.Write(binary.AppendUvarint(nil, n))
+}
@@ -0,0 +1,172 @@
+package extractor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"connectrpc.com/connect"
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+const (
+	defaultBatchConcurrency = 8
+	maxBatchConcurrency     = 32
+	maxBatchSize            = 500
+)
+
+// getBatchConcurrency returns the default number of pages a batch extracts
+// at once, read from EXTRACT_BATCH_CONCURRENCY.
+var getBatchConcurrency = sync.OnceValue(func() int {
+	concurrency, err := strconv.Atoi(os.Getenv("EXTRACT_BATCH_CONCURRENCY"))
+	if err != nil || concurrency <= 0 {
+		return defaultBatchConcurrency
+	}
+
+	return min(concurrency, maxBatchConcurrency)
+})
+
+func (s *ExtractorServer) ExtractBatch(
+	ctx context.Context,
+	req *connect.Request[extractorv1.ExtractBatchRequest],
+) (*connect.Response[extractorv1.ExtractBatchResponse], error) {
+	if err := validateBatch(req.Msg.Urls); err != nil {
+		return nil, err
+	}
+
+	results := make([]*extractorv1.ExtractBatchResult, len(req.Msg.Urls))
+	if err := extractBatch(ctx, req.Msg.Urls, int(req.Msg.Concurrency), req.Msg.Strict, func(result *extractorv1.ExtractBatchResult) error {
+		results[result.Index] = result
+		return nil
+	}); err != nil {
+		// The batch only fails when the request is canceled or times out.
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, err)
+		}
+		return nil, connect.NewError(connect.CodeCanceled, err)
+	}
+
+	return connect.NewResponse(&extractorv1.ExtractBatchResponse{
+		Results: results,
+	}), nil
+}
+
+func (s *ExtractorServer) ExtractBatchStream(
+	ctx context.Context,
+	req *connect.Request[extractorv1.ExtractBatchStreamRequest],
+	stream *connect.ServerStream[extractorv1.ExtractBatchStreamResponse],
+) error {
+	if err := validateBatch(req.Msg.Urls); err != nil {
+		return err
+	}
+
+	return extractBatch(ctx, req.Msg.Urls, int(req.Msg.Concurrency), req.Msg.Strict, func(result *extractorv1.ExtractBatchResult) error {
+		return stream.Send(&extractorv1.ExtractBatchStreamResponse{
+			Result: result,
+		})
+	})
+}
+
+func validateBatch(urls []string) error {
+	if len(urls) == 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("urls is required"))
+	}
+
+	if len(urls) > maxBatchSize {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a batch can have at most %d urls, got %d", maxBatchSize, len(urls)))
+	}
+
+	return nil
+}
+
+// extractBatch extracts urls with a pool of workers and calls emit with each
+// result as it completes. A failing url is reported in its result and does
+// not stop the batch; extractBatch only returns early when emit fails or ctx
+// is done.
+func extractBatch(
+	ctx context.Context,
+	urls []string,
+	concurrency int,
+	strict bool,
+	emit func(*extractorv1.ExtractBatchResult) error,
+) error {
+	if concurrency <= 0 {
+		concurrency = getBatchConcurrency()
+	}
+	concurrency = min(concurrency, maxBatchConcurrency, len(urls))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan int)
+	results := make(chan *extractorv1.ExtractBatchResult)
+
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				select {
+				case results <- extractBatchItem(ctx, index, urls[index], strict):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for index := range urls {
+			select {
+			case jobs <- index:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var emitErr error
+	for result := range results {
+		if emitErr != nil {
+			continue
+		}
+		if err := emit(result); err != nil {
+			emitErr = err
+			cancel()
+		}
+	}
+
+	if emitErr != nil {
+		return emitErr
+	}
+
+	return ctx.Err()
+}
+
+func extractBatchItem(ctx context.Context, index int, pageUrl string, strict bool) *extractorv1.ExtractBatchResult {
+	result := &extractorv1.ExtractBatchResult{
+		Url:   pageUrl,
+		Index: int32(index),
+	}
+
+	docPage, report, err := extractUrl(ctx, pageUrl, strict)
+	result.Report = report
+	if err != nil {
+		result.ErrorCode = connect.CodeOf(err).String()
+		result.ErrorMessage = err.Error()
+		return result
+	}
+
+	result.DocPage = docPage
+	return result
+}
@@ -0,0 +1,72 @@
+package extractor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+func TestExtractBatch(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata/pages")))
+	defer server.Close()
+
+	urls := []string{
+		server.URL + "/managed-pricing.html",
+		server.URL + "/missing.html",
+		server.URL + "/discount-code-basic-create.html",
+		server.URL + "/not-found.html",
+	}
+
+	res, err := (&ExtractorServer{}).ExtractBatch(context.Background(), connect.NewRequest(&extractorv1.ExtractBatchRequest{
+		Urls:        urls,
+		Concurrency: 2,
+	}))
+	if err != nil {
+		t.Fatalf("ExtractBatch: %v", err)
+	}
+
+	results := res.Msg.Results
+	if len(results) != len(urls) {
+		t.Fatalf("got %d results, want %d", len(results), len(urls))
+	}
+
+	wantCodes := []string{"", connect.CodeFailedPrecondition.String(), "", connect.CodeFailedPrecondition.String()}
+	for i, result := range results {
+		if result.Url != urls[i] || int(result.Index) != i {
+			t.Errorf("result %d is for %q (index %d)", i, result.Url, result.Index)
+		}
+		if result.ErrorCode != wantCodes[i] {
+			t.Errorf("result %d: got error code %q, want %q (%s)", i, result.ErrorCode, wantCodes[i], result.ErrorMessage)
+		}
+		if (result.DocPage != nil) != (wantCodes[i] == "") {
+			t.Errorf("result %d: unexpected doc page %v", i, result.DocPage)
+		}
+	}
+}
+
+func TestExtractBatchContextErrors(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata/pages")))
+	defer server.Close()
+
+	expired, cancelExpired := context.WithTimeout(context.Background(), -time.Second)
+	defer cancelExpired()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for ctx, want := range map[context.Context]connect.Code{
+		expired:  connect.CodeDeadlineExceeded,
+		canceled: connect.CodeCanceled,
+	} {
+		_, err := (&ExtractorServer{}).ExtractBatch(ctx, connect.NewRequest(&extractorv1.ExtractBatchRequest{
+			Urls: []string{server.URL + "/managed-pricing.html"},
+		}))
+		if connect.CodeOf(err) != want {
+			t.Errorf("got error %v, want code %v", err, want)
+		}
+	}
+}
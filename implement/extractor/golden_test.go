@@ -0,0 +1,174 @@
+package extractor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	update  = flag.Bool("update", false, "regenerate the golden files in testdata/golden")
+	refresh = flag.Bool("refresh", false, "download the shopify.dev pages in testdata/pages again, then regenerate the golden files")
+)
+
+// goldenPage is a page in testdata/pages and the URL it stands for.
+type goldenPage struct {
+	url string
+	// saved pages are copies of shopify.dev, downloaded by -refresh. The
+	// others are small hand-written fixtures that imitate its markup, or
+	// markup it doesn't serve yet, like renamed selectors.
+	saved bool
+}
+
+var goldenPages = map[string]goldenPage{
+	"managed-pricing":            {url: "https://shopify.dev/docs/apps/launch/billing/managed-pricing"},
+	"discount-code-basic-create": {url: "https://shopify.dev/docs/api/admin-graphql/2024-10/mutations/discountCodeBasicCreate"},
+	"not-found":                  {url: "https://shopify.dev/docs/apps/removed-page"},
+	"renamed-selectors":          {url: "https://shopify.dev/docs/apps/build/webhooks"},
+
+	"shopify-dev-managed-pricing":            {url: "https://shopify.dev/docs/apps/launch/billing/managed-pricing", saved: true},
+	"shopify-dev-discount-code-basic-create": {url: "https://shopify.dev/docs/api/admin-graphql/2024-10/mutations/discountCodeBasicCreate", saved: true},
+	"shopify-dev-webhooks":                   {url: "https://shopify.dev/docs/apps/build/webhooks", saved: true},
+}
+
+func TestGolden(t *testing.T) {
+	for name, page := range goldenPages {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join("testdata", "pages", name+".html")
+			if page.saved {
+				if *refresh {
+					if err := savePage(page.url, path); err != nil {
+						t.Fatalf("refresh %s: %v", page.url, err)
+					}
+				} else if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+					t.Skipf("%s has not been downloaded, run go test -refresh to save it", page.url)
+				}
+			}
+
+			docPage, report, err := ParseDocPageFile(path, page.url)
+			if err != nil {
+				t.Fatalf("ParseDocPageFile: %v", err)
+			}
+
+			gotJson, err := marshalGoldenJson(&extractorv1.ExtractResponse{
+				DocPage: docPage,
+				Report:  report,
+			})
+			if err != nil {
+				t.Fatalf("marshal golden JSON: %v", err)
+			}
+
+			checkGolden(t, filepath.Join("testdata", "golden", name+".json"), gotJson)
+			checkGolden(t, filepath.Join("testdata", "golden", name+".md"), renderGoldenMarkdown(docPage))
+		})
+	}
+}
+
+// savePage downloads a page and saves it without the scripts, styles and
+// inline SVGs that the extractor ignores, to keep the fixtures small. The
+// text/plain scripts holding code examples are kept.
+func savePage(pageUrl, path string) error {
+	resp, err := http.Get(pageUrl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return err
+	}
+	doc.Find("script:not([type='text/plain']), style, noscript, svg, iframe, link[rel='preload'], link[rel='stylesheet']").Remove()
+
+	html, err := goquery.OuterHtml(doc.Selection)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(html+"\n"), 0o644)
+}
+
+// marshalGoldenJson renders the response as indented JSON. protojson output
+// is deliberately unstable, so it is normalised with encoding/json.
+func marshalGoldenJson(res *extractorv1.ExtractResponse) ([]byte, error) {
+	raw, err := protojson.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, raw, "", "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
+
+func renderGoldenMarkdown(docPage *extractorv1.DocPage) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<!-- page: %s -->\n\n%s\n", docPage.SourceUrl, docPage.ContentMarkdown)
+	for _, section := range docPage.DocSections {
+		fmt.Fprintf(&buf, "\n<!-- section %d: %s%s -->\n\n%s\n", section.Order, section.SourceUrl, section.SectionAnchor, section.ContentMarkdown)
+	}
+
+	return buf.Bytes()
+}
+
+func checkGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+
+	if *update || *refresh {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write golden: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden: %v (run go test -update to create it)", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s does not match the extractor output (run go test -update to regenerate)\n%s", path, lineDiff(string(want), string(got)))
+	}
+}
+
+// lineDiff reports the first lines that differ between want and got.
+func lineDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	var out []string
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			out = append(out, fmt.Sprintf("line %d:\n  want: %q\n  got:  %q", i+1, w, g))
+		}
+		if len(out) == 5 {
+			out = append(out, "...")
+			break
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
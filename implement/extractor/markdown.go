@@ -0,0 +1,53 @@
+package extractor
+
+import (
+	"slices"
+	"strings"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+// PageMarkdown returns the whole Markdown of a page. The sections are
+// removed from ContentMarkdown when the page is extracted, so they are
+// appended in order, each under its heading, in place of the list of
+// sections.
+func PageMarkdown(docPage *extractorv1.DocPage) string {
+	var parts []string
+	if content := trimSectionList(docPage.ContentMarkdown); content != "" {
+		parts = append(parts, content)
+	}
+
+	sections := slices.Clone(docPage.DocSections)
+	slices.SortStableFunc(sections, func(a, b *extractorv1.DocSection) int {
+		return int(a.Order) - int(b.Order)
+	})
+	for _, section := range sections {
+		content := strings.TrimSpace(section.ContentMarkdown)
+		// Extracted sections start with their heading already.
+		if section.SectionTitle != "" && !strings.HasPrefix(content, "#") {
+			content = strings.TrimSpace("## " + section.SectionTitle + "\n\n" + content)
+		}
+		if content != "" {
+			parts = append(parts, content)
+		}
+	}
+
+	return strings.Join(parts, "\n\n")
+}
+
+// trimSectionList removes the list of sections appended to the Markdown of
+// a page, whose relative links only repeat the headings.
+func trimSectionList(markdown string) string {
+	markdown = strings.TrimSpace(markdown)
+	index := strings.LastIndex(markdown, "## Sections\n")
+	if index < 0 || (index > 0 && markdown[index-1] != '\n') {
+		return markdown
+	}
+
+	for _, line := range strings.Split(markdown[index:], "\n")[1:] {
+		if line != "" && !strings.HasPrefix(line, "- [") {
+			return markdown
+		}
+	}
+	return strings.TrimSpace(markdown[:index])
+}
@@ -0,0 +1,43 @@
+package extractor
+
+import (
+	"testing"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+func TestPageMarkdown(t *testing.T) {
+	tests := []struct {
+		page *extractorv1.DocPage
+		want string
+	}{
+		{
+			&extractorv1.DocPage{ContentMarkdown: "Intro.\n\n## Sections\n\n- [Plans](/docs/billing#plans)\n- [Limits](/docs/billing#limits)"},
+			"Intro.",
+		},
+		{
+			&extractorv1.DocPage{
+				ContentMarkdown: "Intro.\n\n## Sections\n\n- [Plans](/docs/billing#plans)\n- [Limits](/docs/billing#limits)",
+				DocSections: []*extractorv1.DocSection{
+					{Order: 1, SectionTitle: "Limits", ContentMarkdown: "Up to four plans."},
+					{Order: 0, SectionTitle: "Plans", ContentMarkdown: "## Plans\n\nRecurring charges.\n"},
+				},
+			},
+			"Intro.\n\n## Plans\n\nRecurring charges.\n\n## Limits\n\nUp to four plans.",
+		},
+		{
+			&extractorv1.DocPage{ContentMarkdown: "## Sections\n\nA heading, not the list."},
+			"## Sections\n\nA heading, not the list.",
+		},
+		{
+			&extractorv1.DocPage{DocSections: []*extractorv1.DocSection{{SectionTitle: "Empty"}}},
+			"## Empty",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := PageMarkdown(tt.page); got != tt.want {
+			t.Errorf("PageMarkdown(%v) = %q, want %q", tt.page, got, tt.want)
+		}
+	}
+}
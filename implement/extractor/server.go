@@ -2,10 +2,15 @@ package extractor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"sync"
 
 	"connectrpc.com/connect"
 
@@ -20,45 +25,156 @@ func (s *ExtractorServer) Extract(
 	ctx context.Context,
 	req *connect.Request[extractorv1.ExtractRequest],
 ) (*connect.Response[extractorv1.ExtractResponse], error) {
-	docPage, err := ParseDocPage(req.Msg.Url)
+	var (
+		docPage *extractorv1.DocPage
+		report  *extractorv1.ExtractionReport
+		err     error
+	)
+	switch {
+	case req.Msg.Url != "" && req.Msg.Html != "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only one of url and html can be set"))
+	case req.Msg.Url != "":
+		docPage, report, err = extractUrl(ctx, req.Msg.Url, req.Msg.Strict)
+	case req.Msg.Html != "":
+		if req.Msg.BaseUrl == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("base_url is required with html"))
+		}
+		docPage, report, err = extractHtml(req.Msg.Html, req.Msg.BaseUrl, req.Msg.Strict)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("one of url and html is required"))
+	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse HTML: %w", err))
+		return nil, err
 	}
 
 	res := connect.NewResponse(&extractorv1.ExtractResponse{
 		DocPage: docPage,
+		Report:  report,
 	})
 
 	return res, nil
 }
 
-func ParseDocPage(pageUrl string) (*extractorv1.DocPage, error) {
-	resp, err := http.Get(pageUrl)
+// extractUrl fetches and checks a page, returning connect errors.
+func extractUrl(ctx context.Context, pageUrl string, strict bool) (*extractorv1.DocPage, *extractorv1.ExtractionReport, error) {
+	docPage, report, err := ParseDocPage(ctx, pageUrl)
+	if err != nil {
+		if code := ErrorCode(ctx, err); code != connect.CodeInternal {
+			return nil, nil, connect.NewError(code, err)
+		}
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse HTML: %w", err))
+	}
+
+	if err := ReportError(report, strict); err != nil {
+		return nil, report, newExtractionConnectError(err)
+	}
+
+	return docPage, report, nil
+}
+
+// ErrorCode returns the connect code to report an error of ParseDocPage
+// with: the code of a connect error, Canceled or DeadlineExceeded when ctx
+// ended, and Internal otherwise.
+func ErrorCode(ctx context.Context, err error) connect.Code {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Code()
+	}
+
+	switch ctx.Err() {
+	case context.Canceled:
+		return connect.CodeCanceled
+	case context.DeadlineExceeded:
+		return connect.CodeDeadlineExceeded
+	}
+	return connect.CodeInternal
+}
+
+// extractHtml parses and checks already fetched HTML, returning connect errors.
+func extractHtml(html, baseUrl string, strict bool) (*extractorv1.DocPage, *extractorv1.ExtractionReport, error) {
+	docPage, report, err := ParseDocPageFromReader(strings.NewReader(html), baseUrl)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch page: %w", err)
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse HTML: %w", err))
+	}
+
+	if err := ReportError(report, strict); err != nil {
+		return nil, report, newExtractionConnectError(err)
+	}
+
+	return docPage, report, nil
+}
+
+// ParseDocPage fetches and extracts a documentation page. The returned
+// report lists the issues found by the sanity checks; use ReportError to
+// decide whether the page is usable.
+func ParseDocPage(ctx context.Context, pageUrl string) (*extractorv1.DocPage, *extractorv1.ExtractionReport, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, pageUrl, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch page: %w", err)
 	}
 	defer resp.Body.Close()
 
 	doc, err := goquery.NewDocumentFromReader(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	return parseDocument(doc, pageUrl, resp.StatusCode)
+}
+
+// ParseDocPageFromReader extracts a documentation page from HTML that has
+// already been fetched. pageUrl is the URL the HTML was served from.
+func ParseDocPageFromReader(r io.Reader, pageUrl string) (*extractorv1.DocPage, *extractorv1.ExtractionReport, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse HTML: %w", err)
 	}
 
+	return parseDocument(doc, pageUrl, 0)
+}
+
+// ParseDocPageFile extracts a documentation page from a saved HTML file.
+func ParseDocPageFile(path, pageUrl string) (*extractorv1.DocPage, *extractorv1.ExtractionReport, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	return ParseDocPageFromReader(f, pageUrl)
+}
+
+// parseDocument extracts the page from a parsed document. statusCode is the
+// HTTP status the page was served with, or 0 when unknown.
+func parseDocument(doc *goquery.Document, pageUrl string, statusCode int) (*extractorv1.DocPage, *extractorv1.ExtractionReport, error) {
+	signals := collectPageSignals(doc, statusCode)
+
 	title := strings.TrimSpace(doc.Find(".article-title").Text())
 	sourceUrl := strings.TrimPrefix(pageUrl, "https://shopify.dev")
 	articleDocs := doc.Find(".article--docs")
+	resolveLinks(articleDocs, pageUrl)
 
 	docSections := parseDocSections(articleDocs, title, sourceUrl)
 	articleDocs.Find("#FeedbackFloatingAnchor").Remove()
 
 	contentHtml, err := articleDocs.Html()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get HTML content: %w", err)
+		return nil, nil, fmt.Errorf("failed to get HTML content: %w", err)
 	}
 
 	contentMarkdown, err := convertHtmlToMarkdown(contentHtml)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert HTML to Markdown: %w", err)
+		return nil, nil, fmt.Errorf("failed to convert HTML to Markdown: %w", err)
+	}
+
+	signals.contentLength = len(strings.TrimSpace(contentMarkdown))
+	for _, section := range docSections {
+		signals.contentLength += len(strings.TrimSpace(section.ContentMarkdown))
 	}
 
 	// add section list to the content markdown
@@ -68,12 +184,14 @@ func ParseDocPage(pageUrl string) (*extractorv1.DocPage, error) {
 	}
 	contentMarkdown = fmt.Sprintf("%s\n\n%s", contentMarkdown, sectionList)
 
-	return &extractorv1.DocPage{
+	docPage := &extractorv1.DocPage{
 		SourceTitle:     title,
 		SourceUrl:       sourceUrl,
 		DocSections:     docSections,
 		ContentMarkdown: strings.TrimSpace(contentMarkdown),
-	}, nil
+	}
+
+	return docPage, checkDocPage(signals, docPage), nil
 }
 
 func parseDocSections(articleDocs *goquery.Selection, docTitle, sourceUrl string) []*extractorv1.DocSection {
@@ -126,14 +244,38 @@ func parseDocSections(articleDocs *goquery.Selection, docTitle, sourceUrl string
 	return docSections
 }
 
-var (
-	_mdConverter *md.Converter
-)
+// resolveLinks makes the link and image URLs of the selection absolute,
+// relative to the URL the page was served from. Links to an anchor of the
+// page are kept as is, since section anchors are read from them.
+func resolveLinks(selection *goquery.Selection, pageUrl string) {
+	base, err := url.Parse(pageUrl)
+	if err != nil || !base.IsAbs() {
+		return
+	}
 
-func convertHtmlToMarkdown(html string) (string, error) {
-	if _mdConverter == nil {
-		_mdConverter = md.NewConverter("", true, nil)
+	resolve := func(attr string) func(int, *goquery.Selection) {
+		return func(_ int, element *goquery.Selection) {
+			value := strings.TrimSpace(element.AttrOr(attr, ""))
+			if value == "" || strings.HasPrefix(value, "#") {
+				return
+			}
+			ref, err := url.Parse(value)
+			if err != nil {
+				return
+			}
+			element.SetAttr(attr, base.ResolveReference(ref).String())
+		}
 	}
+	selection.Find("a[href]").Each(resolve("href"))
+	selection.Find("img[src]").Each(resolve("src"))
+}
+
+// getMdConverter returns the converter shared by every page. Batches parse
+// pages concurrently, which the converter supports.
+var getMdConverter = sync.OnceValue(func() *md.Converter {
+	return md.NewConverter("", true, nil)
+})
 
-	return _mdConverter.ConvertString(html)
+func convertHtmlToMarkdown(html string) (string, error) {
+	return getMdConverter().ConvertString(html)
 }
@@ -0,0 +1,80 @@
+package extractor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestResolveLinks(t *testing.T) {
+	html := `<html><head><title>Billing</title></head><body>
+<h1 class="article-title">Billing</h1>
+<div class="article--docs">
+<p>See <a href="../build/discounts">discounts</a>, <a href="/docs/api">the API</a>,
+<a href="#plans">plans</a> and <a href="https://example.com/x">elsewhere</a>.
+<img src="images/plans.png" alt="Plans"></p>
+</div></body></html>`
+
+	docPage, _, err := ParseDocPageFromReader(strings.NewReader(html), "https://shopify.dev/docs/apps/launch/billing")
+	if err != nil {
+		t.Fatalf("ParseDocPageFromReader: %v", err)
+	}
+
+	for _, want := range []string{
+		"[discounts](https://shopify.dev/docs/apps/build/discounts)",
+		"[the API](https://shopify.dev/docs/api)",
+		"[plans](#plans)",
+		"[elsewhere](https://example.com/x)",
+		"![Plans](https://shopify.dev/docs/apps/launch/images/plans.png)",
+	} {
+		if !strings.Contains(docPage.ContentMarkdown, want) {
+			t.Errorf("content does not contain %s:\n%s", want, docPage.ContentMarkdown)
+		}
+	}
+}
+
+// TestConvertHtmlToMarkdownConcurrent converts on several goroutines, as a
+// batch does, for go test -race.
+func TestConvertHtmlToMarkdownConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := convertHtmlToMarkdown("<p><strong>Billing</strong></p>")
+			if err != nil || got != "**Billing**" {
+				t.Errorf("convertHtmlToMarkdown = %q, %v", got, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestErrorCode(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithTimeout(context.Background(), -1)
+	defer cancel()
+
+	tests := []struct {
+		ctx  context.Context
+		err  error
+		want connect.Code
+	}{
+		{context.Background(), errors.New("failed to parse HTML"), connect.CodeInternal},
+		{context.Background(), fmt.Errorf("failed to fetch: %w", connect.NewError(connect.CodeNotFound, errors.New("gone"))), connect.CodeNotFound},
+		{canceled, errors.New("failed to fetch page"), connect.CodeCanceled},
+		{expired, errors.New("failed to fetch page"), connect.CodeDeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		if got := ErrorCode(tt.ctx, tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package extractor
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/PuerkitoBio/goquery"
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+// minContentLength is the number of Markdown characters below which a page
+// is considered suspiciously short.
+const minContentLength = 200
+
+// errorPageMarkers are fragments of the <title> shopify.dev renders for
+// error and fallback pages.
+var errorPageMarkers = []string{
+	"page not found",
+	"something went wrong",
+	"access denied",
+}
+
+// pageSignals holds what we know about the raw page before the extraction
+// mutates the document.
+type pageSignals struct {
+	statusCode    int
+	documentTitle string
+	articleFound  bool
+	headingCount  int
+	// contentLength is the length of the converted Markdown, sections
+	// included, before the section list is appended.
+	contentLength int
+}
+
+func collectPageSignals(doc *goquery.Document, statusCode int) pageSignals {
+	articleDocs := doc.Find(".article--docs")
+
+	return pageSignals{
+		statusCode:    statusCode,
+		documentTitle: strings.ToLower(strings.TrimSpace(doc.Find("head > title").Text())),
+		articleFound:  articleDocs.Length() > 0,
+		headingCount:  articleDocs.Find(".heading-wrapper > h2").Length(),
+	}
+}
+
+// checkDocPage runs the sanity checks on an extracted page and returns the
+// issues it found. An empty report means the page looks fine.
+func checkDocPage(signals pageSignals, docPage *extractorv1.DocPage) *extractorv1.ExtractionReport {
+	report := &extractorv1.ExtractionReport{}
+	addIssue := func(check string, severity extractorv1.ExtractionIssue_Severity, format string, args ...any) {
+		report.Issues = append(report.Issues, &extractorv1.ExtractionIssue{
+			Check:    check,
+			Severity: severity,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	if signals.statusCode >= http.StatusBadRequest {
+		addIssue("http_status", extractorv1.ExtractionIssue_SEVERITY_ERROR, "page responded with HTTP %d", signals.statusCode)
+	}
+
+	for _, marker := range errorPageMarkers {
+		if strings.Contains(signals.documentTitle, marker) {
+			addIssue("error_page", extractorv1.ExtractionIssue_SEVERITY_ERROR, "page title %q looks like an error page", signals.documentTitle)
+			break
+		}
+	}
+
+	if !signals.articleFound {
+		addIssue("article", extractorv1.ExtractionIssue_SEVERITY_ERROR, "no .article--docs element found")
+	}
+
+	if docPage.SourceTitle == "" {
+		addIssue("title", extractorv1.ExtractionIssue_SEVERITY_ERROR, "no .article-title found")
+	}
+
+	switch {
+	case signals.contentLength == 0:
+		addIssue("content", extractorv1.ExtractionIssue_SEVERITY_ERROR, "page has no content")
+	case signals.contentLength < minContentLength:
+		addIssue("content", extractorv1.ExtractionIssue_SEVERITY_WARNING, "page has only %d characters of content, expected at least %d", signals.contentLength, minContentLength)
+	}
+
+	if signals.headingCount > 0 && len(docPage.DocSections) == 0 {
+		addIssue("sections", extractorv1.ExtractionIssue_SEVERITY_WARNING, "page has %d section headings but no .feedback-section was found", signals.headingCount)
+	}
+
+	for _, section := range docPage.DocSections {
+		if section.SectionTitle == "" || section.SectionAnchor == "" {
+			addIssue("sections", extractorv1.ExtractionIssue_SEVERITY_WARNING, "section %d has no title or anchor", section.Order)
+		}
+	}
+
+	return report
+}
+
+// ExtractionError is returned when an extracted page fails its sanity checks.
+type ExtractionError struct {
+	Report *extractorv1.ExtractionReport
+}
+
+func (e *ExtractionError) Error() string {
+	var messages []string
+	for _, issue := range e.Report.Issues {
+		messages = append(messages, fmt.Sprintf("%s: %s", issue.Check, issue.Message))
+	}
+
+	return "extraction checks failed: " + strings.Join(messages, "; ")
+}
+
+// ReportError returns an *ExtractionError if the report contains errors, or
+// warnings when strict is set.
+func ReportError(report *extractorv1.ExtractionReport, strict bool) error {
+	for _, issue := range report.GetIssues() {
+		if issue.Severity == extractorv1.ExtractionIssue_SEVERITY_ERROR ||
+			(strict && issue.Severity == extractorv1.ExtractionIssue_SEVERITY_WARNING) {
+			return &ExtractionError{Report: report}
+		}
+	}
+
+	return nil
+}
+
+// newExtractionConnectError maps an *ExtractionError to a FailedPrecondition
+// error carrying the report as a detail.
+func newExtractionConnectError(err error) *connect.Error {
+	connectErr := connect.NewError(connect.CodeFailedPrecondition, err)
+
+	var extractionErr *ExtractionError
+	if errors.As(err, &extractionErr) {
+		if detail, detailErr := connect.NewErrorDetail(extractionErr.Report); detailErr == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+
+	return connectErr
+}
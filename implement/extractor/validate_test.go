@@ -0,0 +1,74 @@
+package extractor
+
+import (
+	"reflect"
+	"testing"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+func TestCheckDocPage(t *testing.T) {
+	// healthy is a page that passes every check.
+	healthy := pageSignals{
+		statusCode:    200,
+		documentTitle: "managed pricing",
+		articleFound:  true,
+		headingCount:  1,
+		contentLength: minContentLength,
+	}
+	page := &extractorv1.DocPage{
+		SourceTitle: "Managed pricing",
+		DocSections: []*extractorv1.DocSection{{SectionTitle: "Limitations", SectionAnchor: "#limitations"}},
+	}
+
+	tests := []struct {
+		name    string
+		signals func(*pageSignals)
+		page    *extractorv1.DocPage
+		// want lists the check and severity of each expected issue.
+		want []string
+	}{
+		{"healthy", nil, page, nil},
+		{"unknown status", func(s *pageSignals) { s.statusCode = 0 }, page, nil},
+		{"http status", func(s *pageSignals) { s.statusCode = 404 }, page, []string{"http_status SEVERITY_ERROR"}},
+		{"error page", func(s *pageSignals) { s.documentTitle = "page not found | shopify.dev" }, page, []string{"error_page SEVERITY_ERROR"}},
+		{"no article", func(s *pageSignals) { s.articleFound = false }, page, []string{"article SEVERITY_ERROR"}},
+		{"no title", nil, &extractorv1.DocPage{DocSections: page.DocSections}, []string{"title SEVERITY_ERROR"}},
+		{"no content", func(s *pageSignals) { s.contentLength = 0 }, page, []string{"content SEVERITY_ERROR"}},
+		{"short content", func(s *pageSignals) { s.contentLength = minContentLength - 1 }, page, []string{"content SEVERITY_WARNING"}},
+		{"no sections", nil, &extractorv1.DocPage{SourceTitle: "Managed pricing"}, []string{"sections SEVERITY_WARNING"}},
+		{"no headings or sections", func(s *pageSignals) { s.headingCount = 0 }, &extractorv1.DocPage{SourceTitle: "Managed pricing"}, nil},
+		{
+			"untitled section",
+			nil,
+			&extractorv1.DocPage{SourceTitle: "Managed pricing", DocSections: []*extractorv1.DocSection{{SectionAnchor: "#a"}}},
+			[]string{"sections SEVERITY_WARNING"},
+		},
+		{
+			"several issues",
+			func(s *pageSignals) {
+				s.statusCode = 500
+				s.documentTitle = "something went wrong"
+				s.articleFound = false
+				s.contentLength = 0
+			},
+			page,
+			[]string{"http_status SEVERITY_ERROR", "error_page SEVERITY_ERROR", "article SEVERITY_ERROR", "content SEVERITY_ERROR"},
+		},
+	}
+
+	for _, tt := range tests {
+		signals := healthy
+		if tt.signals != nil {
+			tt.signals(&signals)
+		}
+
+		var got []string
+		for _, issue := range checkDocPage(signals, tt.page).Issues {
+			got = append(got, issue.Check+" "+issue.Severity.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got issues %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
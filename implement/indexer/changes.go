@@ -0,0 +1,143 @@
+package indexer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// payloadContentHash is the SHA-256 of the text that was embedded.
+	payloadContentHash = "content_hash"
+	// payloadEmbeddingModel identifies the model that produced the vector.
+	payloadEmbeddingModel = "embedding_model"
+	// payloadPageUrl is the source URL of the page a point belongs to, on
+	// both the page point and its section points.
+	payloadPageUrl = "page_url"
+)
+
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// pointChange is what needs to be written for a point compared to the stored
+// version.
+type pointChange int
+
+const (
+	// pointEmbed means the point is new or its embedded content or model
+	// changed.
+	pointEmbed pointChange = iota
+	// pointKeywords means the dense vector is still valid but the keyword
+	// vector is missing or outdated.
+	pointKeywords
+	// pointPayload means the vectors are still valid but the payload changed.
+	pointPayload
+	// pointUnchanged means the stored point is identical.
+	pointUnchanged
+)
+
+// diffStoredPoints compares points with their stored version. Points whose
+// content hash and embedding model match don't need to be embedded again.
+func diffStoredPoints(ctx context.Context, client *qdrant.Client, collection string, points []*indexPoint) (map[string]pointChange, error) {
+	ids := make([]*qdrant.PointId, 0, len(points))
+	for _, point := range points {
+		ids = append(ids, qdrant.NewIDUUID(point.id))
+	}
+
+	existing, err := client.Get(ctx, &qdrant.GetPoints{
+		CollectionName: collection,
+		Ids:            ids,
+		WithPayload:    qdrant.NewWithPayload(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing points: %w", err)
+	}
+
+	storedPayloads := make(map[string]map[string]*qdrant.Value, len(existing))
+	for _, point := range existing {
+		storedPayloads[point.Id.GetUuid()] = point.Payload
+	}
+
+	changes := make(map[string]pointChange, len(points))
+	for _, point := range points {
+		stored, ok := storedPayloads[point.id]
+		changes[point.id] = classifyPoint(stored, ok, point.payload)
+	}
+
+	return changes, nil
+}
+
+// classifyPoint compares the payload of a point with the stored one. found
+// is false when the point isn't stored.
+func classifyPoint(stored map[string]*qdrant.Value, found bool, payload map[string]*qdrant.Value) pointChange {
+	switch {
+	case !found,
+		stored[payloadContentHash].GetStringValue() != payload[payloadContentHash].GetStringValue(),
+		stored[payloadEmbeddingModel].GetStringValue() != payload[payloadEmbeddingModel].GetStringValue():
+		return pointEmbed
+	case stored[payloadKeywordEncoding].GetStringValue() != payload[payloadKeywordEncoding].GetStringValue():
+		return pointKeywords
+	case !equalPayloads(stored, payload):
+		return pointPayload
+	default:
+		return pointUnchanged
+	}
+}
+
+// equalPayloads compares two payloads, ignoring when they were written.
+func equalPayloads(a, b map[string]*qdrant.Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if key == payloadIndexedAt {
+			continue
+		}
+		if !proto.Equal(value, b[key]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findStalePoints returns the IDs of the stored points of a page that are
+// not in keep, such as sections that were renamed or removed. Points written
+// before page_url was stored are matched on their source URL, as
+// pointPageUrl does.
+func findStalePoints(ctx context.Context, client *qdrant.Client, collection string, pageUrl string, keep []*indexPoint) ([]*qdrant.PointId, error) {
+	keepIds := make(map[string]bool, len(keep))
+	for _, point := range keep {
+		keepIds[point.id] = true
+	}
+
+	var stale []*qdrant.PointId
+	err := scrollPoints(ctx, client, collection, pageUrlFilter(pageUrl), qdrant.NewWithPayloadInclude(payloadPageUrl, "source_url"), false, func(points []*qdrant.RetrievedPoint) error {
+		stale = append(stale, stalePointIds(points, pageUrl, keepIds)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stale, nil
+}
+
+// stalePointIds returns the IDs of the points of the page that are not kept.
+func stalePointIds(points []*qdrant.RetrievedPoint, pageUrl string, keepIds map[string]bool) []*qdrant.PointId {
+	var stale []*qdrant.PointId
+	for _, point := range points {
+		if pointPageUrl(point.Payload) == pageUrl && !keepIds[point.Id.GetUuid()] {
+			stale = append(stale, point.Id)
+		}
+	}
+
+	return stale
+}
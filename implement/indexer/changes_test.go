@@ -0,0 +1,119 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+func TestStalePointIds(t *testing.T) {
+	const pageUrl = "/docs/apps/launch/billing"
+	point := func(id string, payload map[string]*qdrant.Value) *qdrant.RetrievedPoint {
+		return &qdrant.RetrievedPoint{Id: qdrant.NewIDUUID(id), Payload: payload}
+	}
+
+	points := []*qdrant.RetrievedPoint{
+		point("page", map[string]*qdrant.Value{
+			payloadPageUrl: qdrant.NewValueString(pageUrl),
+			"source_url":   qdrant.NewValueString(pageUrl),
+		}),
+		point("removed", map[string]*qdrant.Value{
+			payloadPageUrl: qdrant.NewValueString(pageUrl),
+			"source_url":   qdrant.NewValueString(pageUrl + "#removed"),
+		}),
+		// Written before page_url was stored.
+		point("legacy-kept", map[string]*qdrant.Value{
+			"source_url": qdrant.NewValueString(pageUrl + "#plans"),
+		}),
+		point("legacy-renamed", map[string]*qdrant.Value{
+			"source_url": qdrant.NewValueString(pageUrl + "#old-name"),
+		}),
+		point("legacy-other-page", map[string]*qdrant.Value{
+			"source_url": qdrant.NewValueString(pageUrl + "/usage#plans"),
+		}),
+	}
+	keep := map[string]bool{"page": true, "legacy-kept": true}
+
+	var got []string
+	for _, id := range stalePointIds(points, pageUrl, keep) {
+		got = append(got, id.GetUuid())
+	}
+	if want := []string{"removed", "legacy-renamed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("stale points %v, want %v", got, want)
+	}
+}
+
+func TestEqualPayloads(t *testing.T) {
+	payload := func(fields ...any) map[string]*qdrant.Value {
+		values := make(map[string]*qdrant.Value)
+		for i := 0; i < len(fields); i += 2 {
+			values[fields[i].(string)] = qdrant.NewValueString(fields[i+1].(string))
+		}
+		return values
+	}
+
+	tests := []struct {
+		name string
+		a, b map[string]*qdrant.Value
+		want bool
+	}{
+		{"empty", payload(), payload(), true},
+		{"same", payload("content", "a", payloadPageUrl, "/docs"), payload("content", "a", payloadPageUrl, "/docs"), true},
+		{"indexed_at ignored", payload("content", "a", payloadIndexedAt, "2024-10-01T00:00:00Z"), payload("content", "a", payloadIndexedAt, "2024-11-01T00:00:00Z"), true},
+		{"different value", payload("content", "a"), payload("content", "b"), false},
+		{"extra field", payload("content", "a"), payload("content", "a", payloadApiVersion, "2024-10"), false},
+		{"missing field", payload("content", "a", payloadApiVersion, "2024-10"), payload("content", "a"), false},
+		{"same size, other key", payload("content", "a", payloadApiVersion, "2024-10"), payload("content", "a", payloadContentType, "2024-10"), false},
+		{"indexed_at only on one side", payload("content", "a", payloadIndexedAt, "2024-10-01T00:00:00Z"), payload("content", "a"), false},
+	}
+
+	for _, tt := range tests {
+		if got := equalPayloads(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: equalPayloads = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestClassifyPoint(t *testing.T) {
+	stored := map[string]*qdrant.Value{
+		payloadContentHash:     qdrant.NewValueString("hash"),
+		payloadEmbeddingModel:  qdrant.NewValueString("openai/text-embedding-3-large"),
+		payloadKeywordEncoding: qdrant.NewValueString(keywordEncoding),
+		payloadIndexedAt:       qdrant.NewValueString("2024-10-01T00:00:00Z"),
+		"source_title":         qdrant.NewValueString("Billing"),
+	}
+	with := func(key, value string) map[string]*qdrant.Value {
+		payload := make(map[string]*qdrant.Value, len(stored))
+		for k, v := range stored {
+			payload[k] = v
+		}
+		if value == "" {
+			delete(payload, key)
+		} else {
+			payload[key] = qdrant.NewValueString(value)
+		}
+		return payload
+	}
+
+	tests := []struct {
+		name    string
+		found   bool
+		payload map[string]*qdrant.Value
+		want    pointChange
+	}{
+		{"new point", false, stored, pointEmbed},
+		{"content changed", true, with(payloadContentHash, "other"), pointEmbed},
+		{"model changed", true, with(payloadEmbeddingModel, "openai/text-embedding-3-small"), pointEmbed},
+		{"keyword encoding changed", true, with(payloadKeywordEncoding, "bm25-v0"), pointKeywords},
+		{"keywords missing", true, with(payloadKeywordEncoding, ""), pointKeywords},
+		{"title changed", true, with("source_title", "Managed pricing"), pointPayload},
+		{"reindexed", true, with(payloadIndexedAt, "2024-11-01T00:00:00Z"), pointUnchanged},
+	}
+
+	for _, tt := range tests {
+		if got := classifyPoint(stored, tt.found, tt.payload); got != tt.want {
+			t.Errorf("%s: classifyPoint = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+const deleteBatchSize = 256
+
+func (s *IndexerServer) DeletePage(
+	ctx context.Context,
+	req *connect.Request[indexerv1.DeletePageRequest],
+) (*connect.Response[indexerv1.DeletePageResponse], error) {
+	pageUrl := normalizePageUrl(req.Msg.Url)
+	if pageUrl == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("url is required"))
+	}
+
+	report, err := deletePages(ctx, pageUrlFilter(pageUrl), func(url string) bool {
+		return url == pageUrl
+	}, req.Msg.DryRun)
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(&indexerv1.DeletePageResponse{
+		Report: report,
+	}), nil
+}
+
+func (s *IndexerServer) DeleteByPrefix(
+	ctx context.Context,
+	req *connect.Request[indexerv1.DeleteByPrefixRequest],
+) (*connect.Response[indexerv1.DeleteByPrefixResponse], error) {
+	prefix := normalizePageUrl(req.Msg.Prefix)
+	if prefix == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("prefix is required, use Purge to delete everything"))
+	}
+
+	// page_url has a keyword index, which can't match prefixes, so every
+	// point is read.
+	report, err := deletePages(ctx, nil, func(url string) bool {
+		return strings.HasPrefix(url, prefix)
+	}, req.Msg.DryRun)
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(&indexerv1.DeleteByPrefixResponse{
+		Report: report,
+	}), nil
+}
+
+func (s *IndexerServer) Purge(
+	ctx context.Context,
+	req *connect.Request[indexerv1.PurgeRequest],
+) (*connect.Response[indexerv1.PurgeResponse], error) {
+	if !req.Msg.DryRun && req.Msg.Confirm != collectionName() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("confirm must be %q to purge the collection", collectionName()))
+	}
+
+	report, err := deletePages(ctx, nil, func(string) bool {
+		return true
+	}, req.Msg.DryRun)
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(&indexerv1.PurgeResponse{
+		Report: report,
+	}), nil
+}
+
+// normalizePageUrl turns a shopify.dev URL into the relative source URL the
+// points are stored with.
+func normalizePageUrl(pageUrl string) string {
+	return strings.TrimPrefix(strings.TrimSpace(pageUrl), "https://shopify.dev")
+}
+
+// pointPageUrl returns the URL of the page a point belongs to. Points
+// indexed before page_url was stored fall back to their source URL without
+// the section anchor.
+func pointPageUrl(payload map[string]*qdrant.Value) string {
+	if pageUrl := payload[payloadPageUrl].GetStringValue(); pageUrl != "" {
+		return pageUrl
+	}
+
+	pageUrl, _, _ := strings.Cut(payload["source_url"].GetStringValue(), "#")
+	return pageUrl
+}
+
+// pageUrlFilter selects the points of a page by their indexed page_url, and
+// the points stored without one, whose page is only known from their source
+// URL.
+func pageUrlFilter(pageUrl string) *qdrant.Filter {
+	return &qdrant.Filter{
+		Should: []*qdrant.Condition{
+			qdrant.NewMatchKeyword(payloadPageUrl, pageUrl),
+			qdrant.NewIsEmpty(payloadPageUrl),
+		},
+	}
+}
+
+// deletePages deletes the page and section points of every page whose URL
+// matches, among the points selected by filter. A nil filter reads the whole
+// collection.
+func deletePages(ctx context.Context, filter *qdrant.Filter, match func(pageUrl string) bool, dryRun bool) (*indexerv1.DeletionReport, error) {
+	qdrantClient := getQdrantClient()
+
+	if err := ensureCollectionExists(ctx, qdrantClient); err != nil {
+		return nil, err
+	}
+
+	var ids []*qdrant.PointId
+	pageUrls := make(map[string]bool)
+	err := scrollPoints(ctx, qdrantClient, collectionName(), filter, qdrant.NewWithPayloadInclude(payloadPageUrl, "source_url"), false, func(points []*qdrant.RetrievedPoint) error {
+		for _, point := range points {
+			pageUrl := pointPageUrl(point.Payload)
+			if !match(pageUrl) {
+				continue
+			}
+			ids = append(ids, point.Id)
+			pageUrls[pageUrl] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &indexerv1.DeletionReport{
+		PointsDeleted: int32(len(ids)),
+		DryRun:        dryRun,
+	}
+	for pageUrl := range pageUrls {
+		report.PageUrls = append(report.PageUrls, pageUrl)
+	}
+	sort.Strings(report.PageUrls)
+
+	if dryRun {
+		return report, nil
+	}
+
+	if err := deletePoints(ctx, qdrantClient, collectionName(), ids); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// deletePoints deletes points by ID in batches.
+func deletePoints(ctx context.Context, client *qdrant.Client, collection string, ids []*qdrant.PointId) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	slots := getWriteSlots()
+	if err := slots.acquire(ctx); err != nil {
+		return err
+	}
+	defer slots.release()
+
+	wait := true
+	for start := 0; start < len(ids); start += deleteBatchSize {
+		end := min(start+deleteBatchSize, len(ids))
+		if _, err := client.Delete(ctx, &qdrant.DeletePoints{
+			CollectionName: collection,
+			Wait:           &wait,
+			Points:         qdrant.NewPointsSelectorIDs(ids[start:end]),
+		}); err != nil {
+			return fmt.Errorf("failed to delete points: %w", err)
+		}
+	}
+
+	return nil
+}
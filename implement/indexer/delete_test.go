@@ -0,0 +1,39 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+func TestPointPageUrl(t *testing.T) {
+	tests := []struct {
+		payload map[string]*qdrant.Value
+		want    string
+	}{
+		{map[string]*qdrant.Value{}, ""},
+		{
+			map[string]*qdrant.Value{
+				payloadPageUrl: qdrant.NewValueString("/docs/apps/launch/billing"),
+				"source_url":   qdrant.NewValueString("/docs/apps/launch/billing#plans"),
+			},
+			"/docs/apps/launch/billing",
+		},
+		// Points written before page_url was stored.
+		{map[string]*qdrant.Value{"source_url": qdrant.NewValueString("/docs/apps/launch/billing")}, "/docs/apps/launch/billing"},
+		{map[string]*qdrant.Value{"source_url": qdrant.NewValueString("/docs/apps/launch/billing#plans")}, "/docs/apps/launch/billing"},
+		{
+			map[string]*qdrant.Value{
+				payloadPageUrl: qdrant.NewValueString(""),
+				"source_url":   qdrant.NewValueString("/docs/apps#intro"),
+			},
+			"/docs/apps",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := pointPageUrl(tt.payload); got != tt.want {
+			t.Errorf("pointPageUrl(%v) = %q, want %q", tt.payload, got, tt.want)
+		}
+	}
+}
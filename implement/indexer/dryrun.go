@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"fmt"
+
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// dryRunResponse completes the response of a dry run with the embedding
+// input of every point. Every point is counted as embedded, since a dry run
+// doesn't compare the points with the stored ones.
+func dryRunResponse(response *indexerv1.IndexResponse, points []*indexPoint) (*indexerv1.IndexResponse, error) {
+	for _, point := range points {
+		payload, err := payloadStruct(point.payload)
+		if err != nil {
+			return nil, err
+		}
+
+		input := &indexerv1.EmbeddingInput{
+			PointId:    point.id,
+			Content:    point.content,
+			Payload:    payload,
+			TokenCount: int32(point.tokens),
+		}
+		if point.section != nil {
+			input.SectionAnchor = point.section.SectionAnchor
+			input.SectionTitle = point.section.SectionTitle
+		}
+		response.EmbeddingInputs = append(response.EmbeddingInputs, input)
+		response.TokensEmbedded += int64(point.tokens)
+	}
+	response.EstimatedCostUsd = estimateCost(response.TokensEmbedded, embeddingPricePerMillionTokens())
+
+	response.Success = true
+	response.DryRun = true
+
+	return response, nil
+}
+
+// payloadStruct converts a point payload to a protobuf Struct.
+func payloadStruct(payload map[string]*qdrant.Value) (*structpb.Struct, error) {
+	fields := make(map[string]*structpb.Value, len(payload))
+	for key, value := range payload {
+		converted, err := structValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("payload field %s: %w", key, err)
+		}
+		fields[key] = converted
+	}
+
+	return &structpb.Struct{Fields: fields}, nil
+}
+
+func structValue(value *qdrant.Value) (*structpb.Value, error) {
+	switch kind := value.GetKind().(type) {
+	case *qdrant.Value_NullValue:
+		return structpb.NewNullValue(), nil
+	case *qdrant.Value_BoolValue:
+		return structpb.NewBoolValue(kind.BoolValue), nil
+	case *qdrant.Value_IntegerValue:
+		return structpb.NewNumberValue(float64(kind.IntegerValue)), nil
+	case *qdrant.Value_DoubleValue:
+		return structpb.NewNumberValue(kind.DoubleValue), nil
+	case *qdrant.Value_StringValue:
+		return structpb.NewStringValue(kind.StringValue), nil
+	case *qdrant.Value_ListValue:
+		list := &structpb.ListValue{}
+		for _, element := range kind.ListValue.GetValues() {
+			converted, err := structValue(element)
+			if err != nil {
+				return nil, err
+			}
+			list.Values = append(list.Values, converted)
+		}
+		return structpb.NewListValue(list), nil
+	case *qdrant.Value_StructValue:
+		converted, err := payloadStruct(kind.StructValue.GetFields())
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewStructValue(converted), nil
+	default:
+		return nil, fmt.Errorf("unsupported value %v", value)
+	}
+}
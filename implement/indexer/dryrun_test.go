@@ -0,0 +1,52 @@
+package indexer
+
+import (
+	"testing"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+func TestDryRunResponse(t *testing.T) {
+	section := &extractorv1.DocSection{SectionAnchor: "#usage", SectionTitle: "Usage"}
+	points := []*indexPoint{
+		{
+			id:      "page",
+			content: "page text",
+			tokens:  3,
+			payload: map[string]*qdrant.Value{
+				"source_order":     qdrant.NewValueInt(0),
+				payloadBreadcrumbs: newValueStrings([]string{"apps", "launch"}),
+			},
+		},
+		{
+			id:      "section",
+			section: section,
+			content: "section text that is longer",
+			tokens:  5,
+			payload: map[string]*qdrant.Value{
+				payloadSectionAnchor: qdrant.NewValueString("#usage"),
+			},
+		},
+	}
+
+	response, err := dryRunResponse(&indexerv1.IndexResponse{}, points)
+	if err != nil {
+		t.Fatalf("dryRunResponse: %v", err)
+	}
+	if !response.DryRun || len(response.EmbeddingInputs) != 2 || response.TokensEmbedded != 8 {
+		t.Fatalf("unexpected response %v", response)
+	}
+
+	page := response.EmbeddingInputs[0]
+	breadcrumbs := page.Payload.Fields[payloadBreadcrumbs].GetListValue().AsSlice()
+	if page.Content != "page text" || page.TokenCount != 3 || len(breadcrumbs) != 2 || breadcrumbs[1] != "launch" {
+		t.Errorf("unexpected page input %v", page)
+	}
+
+	input := response.EmbeddingInputs[1]
+	if input.SectionAnchor != "#usage" || input.SectionTitle != "Usage" || input.Payload.Fields[payloadSectionAnchor].GetStringValue() != "#usage" {
+		t.Errorf("unexpected section input %v", input)
+	}
+}
@@ -2,15 +2,20 @@ package indexer
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/generative-ai-go/genai"
 	openai "github.com/sashabaranov/go-openai"
 	"google.golang.org/api/option"
 )
 
-
 var getGeminiClient = sync.OnceValue(func() *genai.Client {
 	ctx := context.Background()
 	client, err := genai.NewClient(ctx, option.WithAPIKey(os.Getenv("GEMINI_API_KEY")))
@@ -20,40 +25,227 @@ var getGeminiClient = sync.OnceValue(func() *genai.Client {
 	return client
 })
 
-var getOpenAiClient = sync.OnceValue(func() *openai.Client {
-	apiKey := os.Getenv("OPENAI_API_KEY")
-	if apiKey == "" {
-		panic("OPENAI_API_KEY environment variable is not set")
+// OpenAIEmbeddingConfig configures the OpenAI embedding provider. Any server
+// with an OpenAI-compatible /embeddings endpoint, such as Ollama, llama.cpp or
+// Text Embeddings Inference, can be used by changing BaseURL and Model.
+type OpenAIEmbeddingConfig struct {
+	BaseURL string
+	APIKey  string
+	// AuthHeader is a "Name: value" header sent instead of the API key as a
+	// bearer token, for servers expecting another header.
+	AuthHeader string
+	Model      string
+	// Dimensions asks the model for shorter vectors. 0 keeps the size of the
+	// model.
+	Dimensions int
+}
+
+const (
+	defaultOpenAIBaseURL        = "https://api.openai.com/v1"
+	defaultOpenAIEmbeddingModel = string(openai.LargeEmbedding3)
+)
+
+// openAIEmbeddingSizes are the vector sizes of the OpenAI embedding models,
+// so they don't need to be probed.
+var openAIEmbeddingSizes = map[string]int{
+	string(openai.LargeEmbedding3): 3072,
+	string(openai.SmallEmbedding3): 1536,
+	string(openai.AdaEmbeddingV2):  1536,
+}
+
+// loadOpenAIEmbeddingConfig reads the provider configuration from
+// OPENAI_BASE_URL, OPENAI_API_KEY, OPENAI_AUTH_HEADER, OPENAI_EMBEDDING_MODEL
+// and OPENAI_EMBEDDING_DIMENSIONS.
+func loadOpenAIEmbeddingConfig() (OpenAIEmbeddingConfig, error) {
+	config := OpenAIEmbeddingConfig{
+		BaseURL:    strings.TrimSuffix(os.Getenv("OPENAI_BASE_URL"), "/"),
+		APIKey:     os.Getenv("OPENAI_API_KEY"),
+		AuthHeader: os.Getenv("OPENAI_AUTH_HEADER"),
+		Model:      os.Getenv("OPENAI_EMBEDDING_MODEL"),
 	}
-	client := openai.NewClient(apiKey)
-	return client
+	if config.BaseURL == "" {
+		config.BaseURL = defaultOpenAIBaseURL
+	}
+	if config.Model == "" {
+		config.Model = defaultOpenAIEmbeddingModel
+	}
+
+	dimensions, err := envUint("OPENAI_EMBEDDING_DIMENSIONS", 0)
+	if err != nil {
+		return config, err
+	}
+	config.Dimensions = int(dimensions)
+
+	if config.AuthHeader != "" {
+		name, value, ok := strings.Cut(config.AuthHeader, ":")
+		if !ok || strings.TrimSpace(name) == "" || strings.TrimSpace(value) == "" {
+			return config, errors.New(`OPENAI_AUTH_HEADER must be "Name: value"`)
+		}
+	}
+
+	return config, nil
+}
+
+// checkAuth fails when the config has no credentials for OpenAI. Self-hosted
+// servers often don't need any.
+func (c OpenAIEmbeddingConfig) checkAuth() error {
+	if c.BaseURL == defaultOpenAIBaseURL && c.APIKey == "" && c.AuthHeader == "" {
+		return errors.New("OPENAI_API_KEY environment variable is not set")
+	}
+
+	return nil
+}
+
+// vectorSize returns the size of the vectors of the configured model, or 0
+// when it is unknown and has to be probed.
+func (c OpenAIEmbeddingConfig) vectorSize() int {
+	if c.Dimensions > 0 {
+		return c.Dimensions
+	}
+
+	return openAIEmbeddingSizes[c.Model]
+}
+
+func (c OpenAIEmbeddingConfig) newClient() *openai.Client {
+	apiKey := c.APIKey
+	if c.AuthHeader != "" {
+		apiKey = ""
+	}
+
+	clientConfig := openai.DefaultConfig(apiKey)
+	clientConfig.BaseURL = c.BaseURL
+	if c.AuthHeader != "" {
+		name, value, _ := strings.Cut(c.AuthHeader, ":")
+		clientConfig.HTTPClient = &headerHTTPClient{
+			client: http.DefaultClient,
+			name:   strings.TrimSpace(name),
+			value:  strings.TrimSpace(value),
+		}
+	}
+
+	return openai.NewClientWithConfig(clientConfig)
+}
+
+// headerHTTPClient sets a header on every request.
+type headerHTTPClient struct {
+	client      openai.HTTPDoer
+	name, value string
+}
+
+func (c *headerHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set(c.name, c.value)
+	return c.client.Do(req)
+}
+
+// getOpenAIEmbeddingConfig returns the provider configuration read from the
+// environment. Servers call CheckCollection at startup, which reports a bad
+// configuration as an error before this panics on it.
+var getOpenAIEmbeddingConfig = sync.OnceValue(func() OpenAIEmbeddingConfig {
+	config, err := loadOpenAIEmbeddingConfig()
+	if err == nil {
+		err = config.checkAuth()
+	}
+	if err != nil {
+		panic(err)
+	}
+	return config
 })
 
 type EmbeddingModel interface {
 	EmbedContent(ctx context.Context, content string) ([]float32, error)
+	// ModelID identifies the model, so vectors from different models are
+	// never mixed up.
+	ModelID() string
 }
 
 type OpenAIEmbeddingModel struct {
-	client *openai.Client	
+	client     *openai.Client
+	model      string
+	dimensions int
 }
 
-func NewOpenAIEmbeddingModel(client *openai.Client) EmbeddingModel {
-	return &OpenAIEmbeddingModel{client: client}
+// NewOpenAIEmbeddingModel embeds with model. dimensions asks for shorter
+// vectors, 0 keeps the size of the model.
+func NewOpenAIEmbeddingModel(client *openai.Client, model string, dimensions int) EmbeddingModel {
+	return &OpenAIEmbeddingModel{client: client, model: model, dimensions: dimensions}
 }
 
 func (m *OpenAIEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
 	resp, err := m.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
-		Input: []string{content},
-		Model: openai.LargeEmbedding3,
+		Input:      []string{content},
+		Model:      openai.EmbeddingModel(m.model),
+		Dimensions: m.dimensions,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if len(resp.Data) == 0 {
+		return nil, errors.New("the embedding response has no data")
+	}
 
 	return resp.Data[0].Embedding, nil
 }
 
+func (m *OpenAIEmbeddingModel) ModelID() string {
+	return "openai/" + m.model
+}
+
+// getProviderEmbeddingModel returns the model of the configured provider.
+// Calls to it are limited by INDEXER_MAX_INFLIGHT_EMBEDDINGS and go through
+// the retry, rate limit and circuit breaker middlewares of the provider.
+var getProviderEmbeddingModel = sync.OnceValue(func() EmbeddingModel {
+	config := getOpenAIEmbeddingConfig()
+	// Retries wait outside the in-flight limit so they don't hold a slot.
+	return ChainEmbeddingModel(
+		newLimitedEmbeddingModel(
+			NewOpenAIEmbeddingModel(config.newClient(), config.Model, config.Dimensions),
+			envInt("INDEXER_MAX_INFLIGHT_EMBEDDINGS", defaultMaxInflightEmbeddings),
+		),
+		loadEmbeddingProviderConfig("openai").Middlewares()...,
+	)
+})
+
+// probeVectorSize embeds a short text to find the size of the vectors of a
+// model.
+func probeVectorSize(ctx context.Context, model EmbeddingModel) (int, error) {
+	vector, err := model.EmbedContent(ctx, "dimension probe")
+	if err != nil {
+		return 0, fmt.Errorf("failed to probe the vector size of %s: %w", model.ModelID(), err)
+	}
+	if len(vector) == 0 {
+		return 0, fmt.Errorf("%s returned an empty vector", model.ModelID())
+	}
+
+	log.Printf("probed %s: %d dimensions", model.ModelID(), len(vector))
+	return len(vector), nil
+}
+
+// getEmbeddingVectorSize is the size of the vectors of the configured model,
+// probed once when it isn't known.
+var getEmbeddingVectorSize = sync.OnceValues(func() (int, error) {
+	config, err := loadOpenAIEmbeddingConfig()
+	if err != nil {
+		return 0, err
+	}
+	if size := config.vectorSize(); size > 0 {
+		return size, nil
+	}
+	if err := config.checkAuth(); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	return probeVectorSize(ctx, getProviderEmbeddingModel())
+})
+
+// getEmbeddingModel returns the model shared by every request: the provider
+// model, served from the embedding cache when it is enabled.
 var getEmbeddingModel = sync.OnceValue(func() EmbeddingModel {
-	openaiClient := getOpenAiClient()
-	return NewOpenAIEmbeddingModel(openaiClient)
+	model := getProviderEmbeddingModel()
+	if cache := getEmbeddingCache(); cache != nil {
+		model = newCachedEmbeddingModel(model, cache, int(getCollectionSchema().VectorSize))
+	}
+
+	return model
 })
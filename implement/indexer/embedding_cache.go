@@ -0,0 +1,415 @@
+package indexer
+
+import (
+	"container/list"
+	"context"
+	"encoding/binary"
+	"errors"
+	"expvar"
+	"fmt"
+	"io/fs"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+const defaultEmbeddingCacheMaxMB = 1024
+
+// EmbeddingCache stores embedding vectors on disk, one file per
+// (model, dimension, content hash), and evicts the least recently used ones
+// once the files exceed the size limit.
+type EmbeddingCache struct {
+	dir      string
+	maxBytes int64
+
+	mu sync.Mutex
+	// lru holds the cached paths relative to dir, most recently used first.
+	lru     *list.List
+	entries map[string]*list.Element
+	bytes   int64
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	writes    atomic.Int64
+	evictions atomic.Int64
+}
+
+type embeddingCacheEntry struct {
+	path string
+	size int64
+}
+
+// EmbeddingCacheStats are the counters of an EmbeddingCache since it was
+// opened, and its current size.
+type EmbeddingCacheStats struct {
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Writes    int64 `json:"writes"`
+	Evictions int64 `json:"evictions"`
+	Entries   int   `json:"entries"`
+	Bytes     int64 `json:"bytes"`
+}
+
+// OpenEmbeddingCache opens the cache in dir, creating it if needed. Files
+// already in dir are loaded in the order they were last used.
+func OpenEmbeddingCache(dir string, maxBytes int64) (*EmbeddingCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create embedding cache: %w", err)
+	}
+
+	type file struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var files []file
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !strings.HasSuffix(path, ".f32") {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		relative, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, file{relative, info.Size(), info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embedding cache: %w", err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	cache := &EmbeddingCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+	for _, f := range files {
+		cache.entries[f.path] = cache.lru.PushFront(&embeddingCacheEntry{f.path, f.size})
+		cache.bytes += f.size
+	}
+
+	cache.mu.Lock()
+	cache.evict()
+	cache.mu.Unlock()
+
+	return cache, nil
+}
+
+// checkCacheKey checks that a model ID and content hash, which may come from
+// stored payloads, make a path inside the cache directory.
+func checkCacheKey(modelID string, hash string) error {
+	if modelID == "" || modelID == "." || modelID == ".." {
+		return fmt.Errorf("invalid embedding model %q", modelID)
+	}
+	if len(hash) != 64 || strings.Trim(hash, "0123456789abcdef") != "" {
+		return fmt.Errorf("invalid content hash %q", hash)
+	}
+
+	return nil
+}
+
+// cachePath returns the path of a vector relative to the cache directory.
+// The model ID and hash must have passed checkCacheKey.
+func cachePath(modelID string, dimension int, hash string) string {
+	model := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' {
+			return '_'
+		}
+		return r
+	}, modelID)
+
+	return filepath.Join(model, strconv.Itoa(dimension), hash[:2], hash+".f32")
+}
+
+// Get returns the cached vector of content embedded by modelID with the
+// given dimension. hash is the contentHash of the content.
+func (c *EmbeddingCache) Get(modelID string, dimension int, hash string) ([]float32, bool) {
+	if checkCacheKey(modelID, hash) != nil {
+		c.misses.Add(1)
+		return nil, false
+	}
+	path := cachePath(modelID, dimension, hash)
+
+	c.mu.Lock()
+	element, ok := c.entries[path]
+	if ok {
+		c.lru.MoveToFront(element)
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.dir, path))
+	if err != nil || len(data) != dimension*4 {
+		// The file was removed or is corrupt: forget it.
+		c.remove(path)
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	// Keep the use order across restarts.
+	now := time.Now()
+	_ = os.Chtimes(filepath.Join(c.dir, path), now, now)
+
+	vector := make([]float32, dimension)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+
+	c.hits.Add(1)
+	return vector, true
+}
+
+// Put stores a vector, evicting the least recently used vectors if the cache
+// grows past its size limit.
+func (c *EmbeddingCache) Put(modelID string, hash string, vector []float32) error {
+	if err := checkCacheKey(modelID, hash); err != nil {
+		return err
+	}
+	path := cachePath(modelID, len(vector), hash)
+
+	data := make([]byte, len(vector)*4)
+	for i, value := range vector {
+		binary.LittleEndian.PutUint32(data[i*4:], math.Float32bits(value))
+	}
+
+	fullPath := filepath.Join(c.dir, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial vector.
+	tmp, err := os.CreateTemp(filepath.Dir(fullPath), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), fullPath); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[path]; ok {
+		entry := element.Value.(*embeddingCacheEntry)
+		c.bytes += int64(len(data)) - entry.size
+		entry.size = int64(len(data))
+		c.lru.MoveToFront(element)
+	} else {
+		c.entries[path] = c.lru.PushFront(&embeddingCacheEntry{path, int64(len(data))})
+		c.bytes += int64(len(data))
+	}
+	c.writes.Add(1)
+	c.evict()
+
+	return nil
+}
+
+// evict removes the least recently used vectors until the cache fits its
+// size limit. c.mu must be held.
+func (c *EmbeddingCache) evict() {
+	for c.bytes > c.maxBytes && c.lru.Len() > 0 {
+		entry := c.lru.Remove(c.lru.Back()).(*embeddingCacheEntry)
+		delete(c.entries, entry.path)
+		c.bytes -= entry.size
+		c.evictions.Add(1)
+
+		if err := os.Remove(filepath.Join(c.dir, entry.path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("failed to evict %s from the embedding cache: %v", entry.path, err)
+		}
+	}
+}
+
+func (c *EmbeddingCache) remove(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[path]; ok {
+		c.lru.Remove(element)
+		delete(c.entries, path)
+		c.bytes -= element.Value.(*embeddingCacheEntry).size
+	}
+	os.Remove(filepath.Join(c.dir, path))
+}
+
+func (c *EmbeddingCache) Stats() EmbeddingCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return EmbeddingCacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Writes:    c.writes.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   c.lru.Len(),
+		Bytes:     c.bytes,
+	}
+}
+
+// cachedEmbeddingModel serves embeddings from an EmbeddingCache and embeds
+// with the wrapped model on a miss.
+type cachedEmbeddingModel struct {
+	EmbeddingModel
+	cache     *EmbeddingCache
+	dimension int
+}
+
+// newCachedEmbeddingModel caches the vectors of model. dimension is the
+// vector size the model is expected to return.
+func newCachedEmbeddingModel(model EmbeddingModel, cache *EmbeddingCache, dimension int) EmbeddingModel {
+	return &cachedEmbeddingModel{
+		EmbeddingModel: model,
+		cache:          cache,
+		dimension:      dimension,
+	}
+}
+
+type cacheHitKey struct{}
+
+// withCacheHit returns a context on which a cachedEmbeddingModel sets hit
+// when it serves the vector from the cache.
+func withCacheHit(ctx context.Context, hit *bool) context.Context {
+	return context.WithValue(ctx, cacheHitKey{}, hit)
+}
+
+func (m *cachedEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	hash := contentHash(content)
+	if vector, ok := m.cache.Get(m.ModelID(), m.dimension, hash); ok {
+		if hit, ok := ctx.Value(cacheHitKey{}).(*bool); ok {
+			*hit = true
+		}
+		return vector, nil
+	}
+
+	vector, err := m.EmbeddingModel.EmbedContent(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+
+	// A vector of another size is an error the caller reports, don't keep it.
+	if len(vector) == m.dimension {
+		if err := m.cache.Put(m.ModelID(), hash, vector); err != nil {
+			log.Printf("failed to cache embedding: %v", err)
+		}
+	}
+
+	return vector, nil
+}
+
+// getEmbeddingCache returns the cache in INDEXER_EMBEDDING_CACHE_DIR, limited
+// to INDEXER_EMBEDDING_CACHE_MAX_MB, or nil when no directory is set. Its
+// stats are published as the embedding_cache expvar.
+var getEmbeddingCache = sync.OnceValue(func() *EmbeddingCache {
+	dir := os.Getenv("INDEXER_EMBEDDING_CACHE_DIR")
+	if dir == "" {
+		return nil
+	}
+
+	maxBytes := int64(envInt("INDEXER_EMBEDDING_CACHE_MAX_MB", defaultEmbeddingCacheMaxMB)) << 20
+	cache, err := OpenEmbeddingCache(dir, maxBytes)
+	if err != nil {
+		panic(err)
+	}
+
+	expvar.Publish("embedding_cache", expvar.Func(func() any {
+		return cache.Stats()
+	}))
+
+	return cache
+})
+
+func (s *IndexerServer) WarmEmbeddingCache(
+	ctx context.Context,
+	req *connect.Request[indexerv1.WarmEmbeddingCacheRequest],
+) (*connect.Response[indexerv1.WarmEmbeddingCacheResponse], error) {
+	cache := getEmbeddingCache()
+	if cache == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the embedding cache is disabled, set INDEXER_EMBEDDING_CACHE_DIR"))
+	}
+
+	qdrantClient := getQdrantClient()
+
+	collection := req.Msg.CollectionVersion
+	if collection == "" {
+		collection = collectionName()
+		if err := ensureCollectionExists(ctx, qdrantClient); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	} else if err := checkCollectionVersion(ctx, qdrantClient, collection); err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	res := &indexerv1.WarmEmbeddingCacheResponse{}
+	err := scrollPoints(ctx, qdrantClient, collection, nil, qdrant.NewWithPayloadInclude(payloadContentHash, payloadEmbeddingModel), true, func(points []*qdrant.RetrievedPoint) error {
+		for _, point := range points {
+			res.PointsScanned++
+
+			hash := point.Payload[payloadContentHash].GetStringValue()
+			modelID := point.Payload[payloadEmbeddingModel].GetStringValue()
+			vector := denseVector(point.Vectors)
+			// Points written before content hashes were stored can't be
+			// matched with their content, and the payload of other points
+			// can't be trusted to make a valid cache path.
+			if checkCacheKey(modelID, hash) != nil || len(vector) == 0 {
+				res.PointsSkipped++
+				continue
+			}
+
+			if err := cache.Put(modelID, hash, vector); err != nil {
+				return fmt.Errorf("failed to cache embedding: %w", err)
+			}
+			res.VectorsCached++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// denseVector returns the default dense vector of a stored point, whether it
+// is stored alone or next to named vectors.
+func denseVector(vectors *qdrant.Vectors) []float32 {
+	if vector := vectors.GetVector(); vector != nil {
+		return vector.GetData()
+	}
+
+	return vectors.GetVectors().GetVectors()[""].GetData()
+}
@@ -0,0 +1,136 @@
+package indexer
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type countingEmbeddingModel struct {
+	calls int
+}
+
+func (m *countingEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	m.calls++
+	return []float32{float32(len(content)), 0.5, -1}, nil
+}
+
+func (m *countingEmbeddingModel) ModelID() string {
+	return "test/model"
+}
+
+func TestCachedEmbeddingModel(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := OpenEmbeddingCache(dir, 1<<20)
+	if err != nil {
+		t.Fatalf("OpenEmbeddingCache: %v", err)
+	}
+
+	inner := &countingEmbeddingModel{}
+	model := newCachedEmbeddingModel(inner, cache, 3)
+
+	var firstHit, secondHit bool
+	first, err := model.EmbedContent(withCacheHit(context.Background(), &firstHit), "hello")
+	if err != nil {
+		t.Fatalf("EmbedContent: %v", err)
+	}
+	second, err := model.EmbedContent(withCacheHit(context.Background(), &secondHit), "hello")
+	if err != nil {
+		t.Fatalf("EmbedContent: %v", err)
+	}
+	if inner.calls != 1 || !slices.Equal(first, second) {
+		t.Errorf("got %d calls and vectors %v, %v, want one call and equal vectors", inner.calls, first, second)
+	}
+	if firstHit || !secondHit {
+		t.Errorf("got cache hits %v, %v, want only the second", firstHit, secondHit)
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Writes != 1 || stats.Entries != 1 || stats.Bytes != 12 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	// A reopened cache still has the vector.
+	reopened, err := OpenEmbeddingCache(dir, 1<<20)
+	if err != nil {
+		t.Fatalf("OpenEmbeddingCache: %v", err)
+	}
+	if vector, ok := reopened.Get("test/model", 3, contentHash("hello")); !ok || !slices.Equal(vector, first) {
+		t.Errorf("reopened cache returned %v, %v", vector, ok)
+	}
+	if _, ok := reopened.Get("test/model", 4, contentHash("hello")); ok {
+		t.Error("a vector of another dimension was returned")
+	}
+}
+
+func TestEmbeddingCacheEviction(t *testing.T) {
+	// Room for two 3-dimension vectors.
+	cache, err := OpenEmbeddingCache(t.TempDir(), 24)
+	if err != nil {
+		t.Fatalf("OpenEmbeddingCache: %v", err)
+	}
+
+	vector := []float32{1, 2, 3}
+	for _, content := range []string{"a", "b"} {
+		if err := cache.Put("test/model", contentHash(content), vector); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+
+	// Using a makes b the least recently used.
+	if _, ok := cache.Get("test/model", 3, contentHash("a")); !ok {
+		t.Fatal("a is not cached")
+	}
+	if err := cache.Put("test/model", contentHash("c"), vector); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	if _, ok := cache.Get("test/model", 3, contentHash("b")); ok {
+		t.Error("b was not evicted")
+	}
+	for _, content := range []string{"a", "c"} {
+		if _, ok := cache.Get("test/model", 3, contentHash(content)); !ok {
+			t.Errorf("%s was evicted", content)
+		}
+	}
+	if stats := cache.Stats(); stats.Evictions != 1 || stats.Bytes != 24 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestEmbeddingCacheInvalidKeys(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := OpenEmbeddingCache(dir, 1024)
+	if err != nil {
+		t.Fatalf("OpenEmbeddingCache: %v", err)
+	}
+
+	hash := contentHash("a")
+	for _, key := range []struct{ modelID, hash string }{
+		{"", hash},
+		{".", hash},
+		{"..", hash},
+		{"test/model", ""},
+		{"test/model", "a"},
+		{"test/model", "../../../etc/passwd"},
+		{"test/model", strings.ToUpper(hash)},
+		{"test/model", hash + "0"},
+	} {
+		if err := cache.Put(key.modelID, key.hash, []float32{1}); err == nil {
+			t.Errorf("Put(%q, %q) succeeded", key.modelID, key.hash)
+		}
+		if _, ok := cache.Get(key.modelID, 1, key.hash); ok {
+			t.Errorf("Get(%q, %q) hit", key.modelID, key.hash)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("the cache directory has %d entries", len(entries))
+	}
+}
@@ -0,0 +1,309 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	openai "github.com/sashabaranov/go-openai"
+	"golang.org/x/time/rate"
+)
+
+// EmbeddingMiddleware wraps an EmbeddingModel to add behaviour around its
+// calls.
+type EmbeddingMiddleware func(EmbeddingModel) EmbeddingModel
+
+// ChainEmbeddingModel wraps model with middlewares. The first middleware is
+// the outermost one, the first to see each call.
+func ChainEmbeddingModel(model EmbeddingModel, middlewares ...EmbeddingMiddleware) EmbeddingModel {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		model = middlewares[i](model)
+	}
+
+	return model
+}
+
+// EmbeddingProviderConfig configures the middlewares around the models of a
+// provider. Zero values disable the corresponding middleware.
+type EmbeddingProviderConfig struct {
+	// MaxRetries is how many times a call failing with a transient error is
+	// retried.
+	MaxRetries int
+	// RetryBaseDelay and RetryMaxDelay bound the exponential backoff between
+	// retries. Each wait is drawn at random below the backoff.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// RequestsPerMinute and TokensPerMinute limit the calls sent.
+	RequestsPerMinute int
+	TokensPerMinute   int
+	// BreakerFailures consecutive transient failures open the circuit for
+	// BreakerCooldown, during which calls fail right away.
+	BreakerFailures int
+	BreakerCooldown time.Duration
+}
+
+// embeddingProviderDefaults are the defaults of each provider, overridden by
+// the <PROVIDER>_EMBEDDING_* environment variables.
+var embeddingProviderDefaults = map[string]EmbeddingProviderConfig{
+	"openai": {
+		MaxRetries:      4,
+		RetryBaseDelay:  500 * time.Millisecond,
+		RetryMaxDelay:   20 * time.Second,
+		BreakerFailures: 5,
+		BreakerCooldown: 30 * time.Second,
+	},
+}
+
+// loadEmbeddingProviderConfig reads the configuration of provider from
+// <PROVIDER>_EMBEDDING_MAX_RETRIES, _RETRY_BASE_DELAY_MS,
+// _RETRY_MAX_DELAY_MS, _RPM, _TPM, _BREAKER_FAILURES and
+// _BREAKER_COOLDOWN_SECONDS. A value of 0 disables the middleware.
+func loadEmbeddingProviderConfig(provider string) EmbeddingProviderConfig {
+	config := embeddingProviderDefaults[provider]
+	prefix := strings.ToUpper(provider) + "_EMBEDDING_"
+
+	config.MaxRetries = envNonNegative(prefix+"MAX_RETRIES", config.MaxRetries)
+	config.RetryBaseDelay = time.Duration(envNonNegative(prefix+"RETRY_BASE_DELAY_MS", int(config.RetryBaseDelay/time.Millisecond))) * time.Millisecond
+	config.RetryMaxDelay = time.Duration(envNonNegative(prefix+"RETRY_MAX_DELAY_MS", int(config.RetryMaxDelay/time.Millisecond))) * time.Millisecond
+	config.RequestsPerMinute = envNonNegative(prefix+"RPM", config.RequestsPerMinute)
+	config.TokensPerMinute = envNonNegative(prefix+"TPM", config.TokensPerMinute)
+	config.BreakerFailures = envNonNegative(prefix+"BREAKER_FAILURES", config.BreakerFailures)
+	config.BreakerCooldown = time.Duration(envNonNegative(prefix+"BREAKER_COOLDOWN_SECONDS", int(config.BreakerCooldown/time.Second))) * time.Second
+
+	return config
+}
+
+// envNonNegative is envInt for settings where 0 disables a feature.
+func envNonNegative(name string, def int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value < 0 {
+		return def
+	}
+
+	return value
+}
+
+// Middlewares returns the middleware chain of the configuration: retries
+// around the circuit breaker around the rate limits, so retries wait for the
+// limits and stop as soon as the circuit opens.
+func (c EmbeddingProviderConfig) Middlewares() []EmbeddingMiddleware {
+	var middlewares []EmbeddingMiddleware
+	if c.MaxRetries > 0 {
+		middlewares = append(middlewares, WithRetry(c.MaxRetries, c.RetryBaseDelay, c.RetryMaxDelay))
+	}
+	if c.BreakerFailures > 0 {
+		middlewares = append(middlewares, WithCircuitBreaker(c.BreakerFailures, c.BreakerCooldown))
+	}
+	if c.RequestsPerMinute > 0 || c.TokensPerMinute > 0 {
+		middlewares = append(middlewares, WithRateLimit(c.RequestsPerMinute, c.TokensPerMinute))
+	}
+
+	return middlewares
+}
+
+// isTransient reports whether a failed embedding call may succeed if
+// retried: rate limiting, server errors and network failures.
+func isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errCircuitOpen) {
+		return false
+	}
+
+	statusCode := 0
+	if apiErr := new(openai.APIError); errors.As(err, &apiErr) {
+		statusCode = apiErr.HTTPStatusCode
+	} else if requestErr := new(openai.RequestError); errors.As(err, &requestErr) {
+		statusCode = requestErr.HTTPStatusCode
+	} else if httpErr := new(httpStatusError); errors.As(err, &httpErr) {
+		statusCode = httpErr.StatusCode
+	}
+	if statusCode != 0 {
+		return statusCode == http.StatusTooManyRequests ||
+			statusCode == http.StatusRequestTimeout ||
+			statusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+type retryEmbeddingModel struct {
+	EmbeddingModel
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+}
+
+// WithRetry retries calls failing with a transient error up to maxRetries
+// times, waiting a random duration below an exponential backoff ("full
+// jitter") so concurrent callers don't retry in lockstep.
+func WithRetry(maxRetries int, baseDelay, maxDelay time.Duration) EmbeddingMiddleware {
+	return func(model EmbeddingModel) EmbeddingModel {
+		return &retryEmbeddingModel{
+			EmbeddingModel: model,
+			maxRetries:     maxRetries,
+			baseDelay:      baseDelay,
+			maxDelay:       maxDelay,
+		}
+	}
+}
+
+func (m *retryEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	for attempt := 0; ; attempt++ {
+		vector, err := m.EmbeddingModel.EmbedContent(ctx, content)
+		if err == nil || attempt >= m.maxRetries || !isTransient(err) {
+			return vector, err
+		}
+
+		timer := time.NewTimer(rand.N(backoffDelay(m.baseDelay, m.maxDelay, attempt) + 1))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, errors.Join(err, ctx.Err())
+		}
+	}
+}
+
+// backoffDelay returns baseDelay doubled attempt times, capped at maxDelay.
+// It stops doubling at the cap, so any number of attempts is safe.
+func backoffDelay(baseDelay, maxDelay time.Duration, attempt int) time.Duration {
+	backoff := baseDelay
+	for i := 0; i < attempt && backoff < maxDelay; i++ {
+		backoff *= 2
+	}
+
+	return max(min(backoff, maxDelay), 0)
+}
+
+type rateLimitedEmbeddingModel struct {
+	EmbeddingModel
+	requests *rate.Limiter
+	tokens   *rate.Limiter
+}
+
+// WithRateLimit spreads calls so that at most requestsPerMinute calls and
+// tokensPerMinute input tokens are sent per minute. A limit of 0 is not
+// enforced. An input larger than tokensPerMinute tokens can never be sent
+// and is rejected.
+func WithRateLimit(requestsPerMinute, tokensPerMinute int) EmbeddingMiddleware {
+	return func(model EmbeddingModel) EmbeddingModel {
+		m := &rateLimitedEmbeddingModel{EmbeddingModel: model}
+		if requestsPerMinute > 0 {
+			m.requests = rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60), max(requestsPerMinute/60, 1))
+		}
+		if tokensPerMinute > 0 {
+			// The burst is a whole minute of tokens, so every input up to the
+			// limit is charged in full.
+			m.tokens = rate.NewLimiter(rate.Limit(float64(tokensPerMinute)/60), tokensPerMinute)
+		}
+		return m
+	}
+}
+
+func (m *rateLimitedEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	tokens := estimateTokens(content)
+	if m.tokens != nil && tokens > m.tokens.Burst() {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("input of about %d tokens exceeds the limit of %d tokens per minute", tokens, m.tokens.Burst()))
+	}
+
+	if m.requests != nil {
+		if err := m.requests.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("waiting for the request rate limit: %w", err)
+		}
+	}
+	if m.tokens != nil {
+		if err := m.tokens.WaitN(ctx, tokens); err != nil {
+			return nil, fmt.Errorf("waiting for the token rate limit: %w", err)
+		}
+	}
+
+	return m.EmbeddingModel.EmbedContent(ctx, content)
+}
+
+var errCircuitOpen = errors.New("embedding provider circuit is open after repeated failures")
+
+type circuitBreakerEmbeddingModel struct {
+	EmbeddingModel
+	failureThreshold int
+	cooldown         time.Duration
+
+	mu       sync.Mutex
+	failures int
+	// openUntil is when an open circuit lets a probe call through.
+	openUntil time.Time
+	probing   bool
+}
+
+// WithCircuitBreaker fails calls right away for cooldown once
+// failureThreshold consecutive calls failed with a transient error, instead
+// of piling more load on a provider that is down. After the cooldown a
+// single probe call is let through; its success closes the circuit.
+func WithCircuitBreaker(failureThreshold int, cooldown time.Duration) EmbeddingMiddleware {
+	return func(model EmbeddingModel) EmbeddingModel {
+		return &circuitBreakerEmbeddingModel{
+			EmbeddingModel:   model,
+			failureThreshold: failureThreshold,
+			cooldown:         cooldown,
+		}
+	}
+}
+
+func (m *circuitBreakerEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	probe, err := m.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := m.EmbeddingModel.EmbedContent(ctx, content)
+	m.record(err, probe)
+
+	return vector, err
+}
+
+// allow reports whether a call can go through, and whether it is the probe
+// of a half-open circuit.
+func (m *circuitBreakerEmbeddingModel) allow() (probe bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.failures < m.failureThreshold {
+		return false, nil
+	}
+	if m.probing || time.Now().Before(m.openUntil) {
+		return false, connect.NewError(connect.CodeUnavailable, errCircuitOpen)
+	}
+
+	// Half open: this call is the probe.
+	m.probing = true
+	return true, nil
+}
+
+// record counts the outcome of a call. Only the probe ends the half-open
+// state: calls let through before the circuit opened may still finish.
+func (m *circuitBreakerEmbeddingModel) record(err error, probe bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if probe {
+		m.probing = false
+	}
+	switch {
+	case err == nil:
+		m.failures = 0
+	case isTransient(err):
+		m.failures++
+		if m.failures >= m.failureThreshold {
+			m.openUntil = time.Now().Add(m.cooldown)
+		}
+	}
+}
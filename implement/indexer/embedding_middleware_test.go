@@ -0,0 +1,245 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// failingEmbeddingModel fails its first failures calls with err.
+type failingEmbeddingModel struct {
+	err      error
+	failures int
+	calls    int
+}
+
+func (m *failingEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	m.calls++
+	if m.calls <= m.failures {
+		return nil, m.err
+	}
+	return []float32{1}, nil
+}
+
+func (m *failingEmbeddingModel) ModelID() string {
+	return "test/model"
+}
+
+var errTooManyRequests = &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests, Message: "rate limited"}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errTooManyRequests, true},
+		{&openai.RequestError{HTTPStatusCode: http.StatusBadGateway}, true},
+		{&openai.APIError{HTTPStatusCode: http.StatusBadRequest}, false},
+		{&httpStatusError{StatusCode: http.StatusServiceUnavailable}, true},
+		{context.Canceled, false},
+		{connect.NewError(connect.CodeUnavailable, errCircuitOpen), false},
+		{errors.New("invalid input"), false},
+	}
+	for _, test := range tests {
+		if got := isTransient(test.err); got != test.want {
+			t.Errorf("isTransient(%v) = %v, want %v", test.err, got, test.want)
+		}
+	}
+}
+
+func TestRetryEmbeddingModel(t *testing.T) {
+	inner := &failingEmbeddingModel{err: errTooManyRequests, failures: 2}
+	model := ChainEmbeddingModel(inner, WithRetry(3, time.Millisecond, time.Millisecond))
+	if _, err := model.EmbedContent(context.Background(), "hello"); err != nil || inner.calls != 3 {
+		t.Errorf("got %v after %d calls, want success after 3 calls", err, inner.calls)
+	}
+
+	inner = &failingEmbeddingModel{err: errTooManyRequests, failures: 10}
+	model = ChainEmbeddingModel(inner, WithRetry(3, time.Millisecond, time.Millisecond))
+	if _, err := model.EmbedContent(context.Background(), "hello"); !errors.Is(err, errTooManyRequests) || inner.calls != 4 {
+		t.Errorf("got %v after %d calls, want the provider error after 4 calls", err, inner.calls)
+	}
+
+	// Other errors are not retried.
+	inner = &failingEmbeddingModel{err: &openai.APIError{HTTPStatusCode: http.StatusBadRequest}, failures: 10}
+	model = ChainEmbeddingModel(inner, WithRetry(3, time.Millisecond, time.Millisecond))
+	if _, err := model.EmbedContent(context.Background(), "hello"); err == nil || inner.calls != 1 {
+		t.Errorf("got %v after %d calls, want an error after 1 call", err, inner.calls)
+	}
+}
+
+func TestCircuitBreakerEmbeddingModel(t *testing.T) {
+	inner := &failingEmbeddingModel{err: errTooManyRequests, failures: 2}
+	model := ChainEmbeddingModel(inner, WithCircuitBreaker(2, 20*time.Millisecond))
+
+	for range 2 {
+		if _, err := model.EmbedContent(context.Background(), "hello"); !errors.Is(err, errTooManyRequests) {
+			t.Fatalf("got %v, want the provider error", err)
+		}
+	}
+
+	// The circuit is open: calls fail without reaching the provider.
+	_, err := model.EmbedContent(context.Background(), "hello")
+	if !errors.Is(err, errCircuitOpen) || connect.CodeOf(err) != connect.CodeUnavailable || inner.calls != 2 {
+		t.Fatalf("got %v after %d calls, want an open circuit after 2 calls", err, inner.calls)
+	}
+
+	// After the cooldown a probe goes through and closes the circuit.
+	time.Sleep(30 * time.Millisecond)
+	for range 2 {
+		if _, err := model.EmbedContent(context.Background(), "hello"); err != nil {
+			t.Fatalf("got %v, want a closed circuit", err)
+		}
+	}
+	if inner.calls != 4 {
+		t.Errorf("got %d calls, want 4", inner.calls)
+	}
+}
+
+func TestRateLimitedEmbeddingModel(t *testing.T) {
+	inner := &failingEmbeddingModel{}
+	// One request per second, with a burst of one.
+	model := ChainEmbeddingModel(inner, WithRateLimit(60, 0))
+
+	if _, err := model.EmbedContent(context.Background(), "hello"); err != nil {
+		t.Fatalf("EmbedContent: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := model.EmbedContent(ctx, "hello"); err == nil || inner.calls != 1 {
+		t.Errorf("got %v after %d calls, want the second call to wait past the deadline", err, inner.calls)
+	}
+}
+
+func TestRateLimitedEmbeddingModelTokens(t *testing.T) {
+	inner := &failingEmbeddingModel{}
+	// 120 tokens per minute, 2 per second.
+	model := ChainEmbeddingModel(inner, WithRateLimit(0, 120))
+
+	// About 100 tokens, sent right away.
+	if _, err := model.EmbedContent(context.Background(), strings.Repeat("x", 400)); err != nil {
+		t.Fatalf("EmbedContent: %v", err)
+	}
+
+	// The second input is charged in full, and waits for 40 seconds.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := model.EmbedContent(ctx, strings.Repeat("x", 400)); err == nil || inner.calls != 1 {
+		t.Errorf("got %v after %d calls, want the second call to wait past the deadline", err, inner.calls)
+	}
+
+	// About 150 tokens never fit in a minute.
+	if _, err := model.EmbedContent(context.Background(), strings.Repeat("x", 600)); connect.CodeOf(err) != connect.CodeInvalidArgument || inner.calls != 1 {
+		t.Errorf("got %v after %d calls, want the input to be rejected", err, inner.calls)
+	}
+}
+
+func TestLoadEmbeddingProviderConfig(t *testing.T) {
+	t.Setenv("OPENAI_EMBEDDING_MAX_RETRIES", "0")
+	t.Setenv("OPENAI_EMBEDDING_TPM", "1000000")
+	t.Setenv("OPENAI_EMBEDDING_BREAKER_COOLDOWN_SECONDS", "invalid")
+
+	config := loadEmbeddingProviderConfig("openai")
+	if config.MaxRetries != 0 || config.TokensPerMinute != 1000000 || config.BreakerCooldown != 30*time.Second {
+		t.Errorf("unexpected config %+v", config)
+	}
+	if got := len(config.Middlewares()); got != 2 {
+		t.Errorf("got %d middlewares, want the circuit breaker and the rate limit", got)
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 500 * time.Millisecond},
+		{1, time.Second},
+		{5, 16 * time.Second},
+		{6, 20 * time.Second},
+		{40, 20 * time.Second},
+		{1 << 20, 20 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := backoffDelay(500*time.Millisecond, 20*time.Second, tt.attempt); got != tt.want {
+			t.Errorf("backoffDelay(attempt %d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+// gatedEmbeddingModel blocks each call until its result is sent on the
+// channel of its content.
+type gatedEmbeddingModel struct {
+	started chan string
+	results map[string]chan error
+}
+
+func (m *gatedEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	m.started <- content
+	if err := <-m.results[content]; err != nil {
+		return nil, err
+	}
+	return []float32{1}, nil
+}
+
+func (m *gatedEmbeddingModel) ModelID() string {
+	return "test/gated"
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	inner := &gatedEmbeddingModel{started: make(chan string, 10), results: make(map[string]chan error)}
+	for _, content := range []string{"early", "failing", "probe", "after"} {
+		inner.results[content] = make(chan error, 1)
+	}
+	model := ChainEmbeddingModel(inner, WithCircuitBreaker(1, 10*time.Millisecond))
+
+	done := make(map[string]chan error)
+	call := func(content string) {
+		result := make(chan error, 1)
+		done[content] = result
+		go func() {
+			_, err := model.EmbedContent(context.Background(), content)
+			result <- err
+		}()
+	}
+
+	// early is let through before the circuit opens, and is still running
+	// when failing opens it.
+	call("early")
+	<-inner.started
+	call("failing")
+	<-inner.started
+	inner.results["failing"] <- errTooManyRequests
+	<-done["failing"]
+
+	time.Sleep(20 * time.Millisecond)
+	call("probe")
+	if content := <-inner.started; content != "probe" {
+		t.Fatalf("%s started, want the probe", content)
+	}
+
+	// early finishing doesn't end the half-open state. Its error isn't
+	// transient, so it doesn't open the circuit again either.
+	inner.results["early"] <- errors.New("invalid input")
+	<-done["early"]
+	inner.results["after"] <- nil
+	if _, err := model.EmbedContent(context.Background(), "after"); !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("got %v during the probe, want an open circuit", err)
+	}
+
+	inner.results["probe"] <- nil
+	if err := <-done["probe"]; err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	if _, err := model.EmbedContent(context.Background(), "after"); err != nil {
+		t.Fatalf("got %v after the probe succeeded, want a closed circuit", err)
+	}
+}
@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLoadOpenAIEmbeddingConfig(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+	t.Setenv("OPENAI_BASE_URL", "")
+	t.Setenv("OPENAI_AUTH_HEADER", "")
+	t.Setenv("OPENAI_EMBEDDING_MODEL", "")
+	t.Setenv("OPENAI_EMBEDDING_DIMENSIONS", "")
+
+	config, err := loadOpenAIEmbeddingConfig()
+	if err != nil {
+		t.Fatalf("loadOpenAIEmbeddingConfig: %v", err)
+	}
+	if config.BaseURL != defaultOpenAIBaseURL || config.Model != defaultOpenAIEmbeddingModel || config.vectorSize() != 3072 {
+		t.Errorf("unexpected default config %+v", config)
+	}
+	if config.checkAuth() == nil {
+		t.Error("expected an error for OpenAI without a key")
+	}
+
+	t.Setenv("OPENAI_BASE_URL", "http://localhost:11434/v1/")
+	t.Setenv("OPENAI_EMBEDDING_MODEL", "nomic-embed-text")
+	config, err = loadOpenAIEmbeddingConfig()
+	if err != nil {
+		t.Fatalf("loadOpenAIEmbeddingConfig: %v", err)
+	}
+	if config.BaseURL != "http://localhost:11434/v1" || config.vectorSize() != 0 || config.checkAuth() != nil {
+		t.Errorf("unexpected self-hosted config %+v", config)
+	}
+
+	t.Setenv("OPENAI_EMBEDDING_DIMENSIONS", "256")
+	if config, _ := loadOpenAIEmbeddingConfig(); config.vectorSize() != 256 {
+		t.Errorf("got vector size %d, want the configured dimensions", config.vectorSize())
+	}
+
+	t.Setenv("OPENAI_AUTH_HEADER", "X-API-Key")
+	if _, err := loadOpenAIEmbeddingConfig(); err == nil {
+		t.Error("expected an error for an auth header without a value")
+	}
+}
+
+func TestOpenAICompatibleEmbeddingModel(t *testing.T) {
+	var request struct {
+		Model      string `json:"model"`
+		Dimensions int    `json:"dimensions"`
+	}
+	var header, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-API-Key")
+		authorization = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&request)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","data":[{"object":"embedding","index":0,"embedding":[0.1,0.2,0.3,0.4]}]}`))
+	}))
+	defer server.Close()
+
+	config := OpenAIEmbeddingConfig{
+		BaseURL:    server.URL,
+		APIKey:     "unused",
+		AuthHeader: "X-API-Key: secret",
+		Model:      "bge-small",
+		Dimensions: 4,
+	}
+	model := NewOpenAIEmbeddingModel(config.newClient(), config.Model, config.Dimensions)
+
+	size, err := probeVectorSize(context.Background(), model)
+	if err != nil {
+		t.Fatalf("probeVectorSize: %v", err)
+	}
+	if size != 4 || model.ModelID() != "openai/bge-small" {
+		t.Errorf("got size %d for %s", size, model.ModelID())
+	}
+	if request.Model != "bge-small" || request.Dimensions != 4 {
+		t.Errorf("unexpected request %+v", request)
+	}
+	if header != "secret" || authorization != "" {
+		t.Errorf("got X-API-Key %q and Authorization %q, want only the custom header", header, authorization)
+	}
+}
@@ -0,0 +1,104 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	openai "github.com/sashabaranov/go-openai"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// IndexError is returned by IndexDocPage when some points of the page could
+// not be embedded or written. Nothing of the page was written; Response
+// lists the status of every point.
+type IndexError struct {
+	Code     connect.Code
+	Response *indexerv1.IndexResponse
+	err      error
+}
+
+func (e *IndexError) Error() string {
+	return e.err.Error()
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.err
+}
+
+// errorCode maps an embedding, reranking or storage error to the connect code
+// that best tells clients whether retrying makes sense.
+func errorCode(err error) connect.Code {
+	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+		return connectErr.Code()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return connect.CodeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.CodeDeadlineExceeded
+	}
+
+	statusCode := 0
+	if apiErr := new(openai.APIError); errors.As(err, &apiErr) {
+		statusCode = apiErr.HTTPStatusCode
+	} else if requestErr := new(openai.RequestError); errors.As(err, &requestErr) {
+		statusCode = requestErr.HTTPStatusCode
+	} else if httpErr := new(httpStatusError); errors.As(err, &httpErr) {
+		statusCode = httpErr.StatusCode
+	}
+
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return connect.CodeResourceExhausted
+	case statusCode == http.StatusUnauthorized:
+		return connect.CodeUnauthenticated
+	case statusCode == http.StatusForbidden:
+		return connect.CodePermissionDenied
+	case statusCode >= http.StatusInternalServerError:
+		return connect.CodeUnavailable
+	case statusCode >= http.StatusBadRequest:
+		return connect.CodeInvalidArgument
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// newIndexConnectError converts an error from IndexDocPage to a connect
+// error. An *IndexError keeps its code and carries the per-point results and
+// an ErrorInfo listing the failed sections as details.
+func newIndexConnectError(err error) *connect.Error {
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) {
+		return connect.NewError(errorCode(err), err)
+	}
+
+	connectErr := connect.NewError(indexErr.Code, err)
+
+	info := &errdetails.ErrorInfo{
+		Reason:   "INDEX_POINTS_FAILED",
+		Domain:   "indexer.v1",
+		Metadata: map[string]string{},
+	}
+	for _, point := range indexErr.Response.Points {
+		if point.Status == indexerv1.PointResult_STATUS_FAILED {
+			key := point.SectionAnchor
+			if key == "" {
+				key = "page"
+			}
+			info.Metadata[key] = point.ErrorCode
+		}
+	}
+
+	for _, detail := range []proto.Message{info, indexErr.Response} {
+		if errDetail, detailErr := connect.NewErrorDetail(detail); detailErr == nil {
+			connectErr.AddDetail(errDetail)
+		}
+	}
+
+	return connectErr
+}
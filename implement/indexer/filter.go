@@ -0,0 +1,401 @@
+package indexer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Filter expressions select points by their payload, for example:
+//
+//	content_type = "mutation" AND api_version IN ("2024-10", "2025-01")
+//	breadcrumbs = "billing" AND NOT section_anchor = ""
+//	indexed_at >= "2024-10-01T00:00:00Z" OR source_order < 3
+//
+// Conditions compare a payload field with a quoted string or a number using
+// =, !=, <, <=, >, >= or IN (...). They are combined with AND, OR, NOT and
+// parentheses; AND binds tighter than OR. A condition on a list field such as
+// breadcrumbs matches when any element matches.
+
+type filterFieldType int
+
+const (
+	filterKeyword filterFieldType = iota
+	filterInteger
+	filterDatetime
+)
+
+// filterFields are the payload fields filter expressions can match on.
+var filterFields = map[string]filterFieldType{
+	payloadPageUrl:        filterKeyword,
+	"source_url":          filterKeyword,
+	payloadSectionAnchor:  filterKeyword,
+	payloadBreadcrumbs:    filterKeyword,
+	payloadApiVersion:     filterKeyword,
+	payloadContentType:    filterKeyword,
+	payloadEmbeddingModel: filterKeyword,
+	"source_order":        filterInteger,
+	payloadIndexedAt:      filterDatetime,
+}
+
+// parseFilter compiles a filter expression to a Qdrant filter. An empty
+// expression returns a nil filter, which matches every point.
+func parseFilter(expression string) (*qdrant.Filter, error) {
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	parser := &filterParser{tokens: tokens}
+	condition, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !parser.done() {
+		return nil, fmt.Errorf("unexpected %s", describeToken(parser.peek()))
+	}
+
+	return &qdrant.Filter{
+		Must: []*qdrant.Condition{condition},
+	}, nil
+}
+
+type filterTokenKind int
+
+const (
+	tokenIdent filterTokenKind = iota
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+func tokenizeFilter(expression string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{tokenLeftParen, "(", i})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{tokenRightParen, ")", i})
+			i++
+		case r == ',':
+			tokens = append(tokens, filterToken{tokenComma, ",", i})
+			i++
+		case r == '"' || r == '\'':
+			start := i
+			var value strings.Builder
+			for i++; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			tokens = append(tokens, filterToken{tokenString, value.String(), start})
+			i++
+		case strings.ContainsRune("=!<>", r):
+			start := i
+			i++
+			if i < len(runes) && runes[i] == '=' {
+				i++
+			}
+			operator := string(runes[start:i])
+			if operator == "!" {
+				return nil, fmt.Errorf("unexpected ! at position %d", start)
+			}
+			tokens = append(tokens, filterToken{tokenOperator, operator, start})
+		case unicode.IsDigit(r) || r == '-':
+			start := i
+			for i++; i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.'); i++ {
+			}
+			tokens = append(tokens, filterToken{tokenNumber, string(runes[start:i]), start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for ; i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_'); i++ {
+			}
+			tokens = append(tokens, filterToken{tokenIdent, string(runes[start:i]), start})
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", r, i)
+		}
+	}
+
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	next   int
+}
+
+func (p *filterParser) done() bool {
+	return p.next >= len(p.tokens)
+}
+
+func (p *filterParser) peek() filterToken {
+	if p.done() {
+		return filterToken{text: "end of expression", pos: -1}
+	}
+	return p.tokens[p.next]
+}
+
+// keyword consumes the next token if it is the keyword word, in any case.
+func (p *filterParser) keyword(word string) bool {
+	if !p.done() && p.peek().kind == tokenIdent && strings.EqualFold(p.peek().text, word) {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) expect(kind filterTokenKind, what string) (filterToken, error) {
+	token := p.peek()
+	if p.done() || token.kind != kind {
+		return token, fmt.Errorf("expected %s, got %s", what, describeToken(token))
+	}
+	p.next++
+	return token, nil
+}
+
+func describeToken(token filterToken) string {
+	if token.pos < 0 {
+		return token.text
+	}
+	return fmt.Sprintf("%q at position %d", token.text, token.pos)
+}
+
+func (p *filterParser) parseOr() (*qdrant.Condition, error) {
+	conditions, err := p.parseList("OR", p.parseAnd)
+	if err != nil {
+		return nil, err
+	}
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+
+	return qdrant.NewFilterAsCondition(&qdrant.Filter{Should: conditions}), nil
+}
+
+func (p *filterParser) parseAnd() (*qdrant.Condition, error) {
+	conditions, err := p.parseList("AND", p.parseUnary)
+	if err != nil {
+		return nil, err
+	}
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+
+	return qdrant.NewFilterAsCondition(&qdrant.Filter{Must: conditions}), nil
+}
+
+func (p *filterParser) parseList(separator string, parse func() (*qdrant.Condition, error)) ([]*qdrant.Condition, error) {
+	var conditions []*qdrant.Condition
+	for {
+		condition, err := parse()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+
+		if !p.keyword(separator) {
+			return conditions, nil
+		}
+	}
+}
+
+func (p *filterParser) parseUnary() (*qdrant.Condition, error) {
+	if p.keyword("NOT") {
+		condition, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return not(condition), nil
+	}
+
+	if !p.done() && p.peek().kind == tokenLeftParen {
+		p.next++
+		condition, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return condition, nil
+	}
+
+	return p.parseComparison()
+}
+
+func not(condition *qdrant.Condition) *qdrant.Condition {
+	return qdrant.NewFilterAsCondition(&qdrant.Filter{MustNot: []*qdrant.Condition{condition}})
+}
+
+func (p *filterParser) parseComparison() (*qdrant.Condition, error) {
+	fieldToken, err := p.expect(tokenIdent, "a field name")
+	if err != nil {
+		return nil, err
+	}
+
+	field := fieldToken.text
+	fieldType, ok := filterFields[field]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q at position %d", field, fieldToken.pos)
+	}
+
+	if p.keyword("IN") {
+		return p.parseIn(field, fieldType)
+	}
+
+	operatorToken, err := p.expect(tokenOperator, "an operator")
+	if err != nil {
+		return nil, err
+	}
+
+	valueToken := p.peek()
+	if p.done() || (valueToken.kind != tokenString && valueToken.kind != tokenNumber) {
+		return nil, fmt.Errorf("expected a value, got %s", describeToken(valueToken))
+	}
+	p.next++
+
+	switch operatorToken.text {
+	case "=", "!=":
+		condition, err := matchCondition(field, fieldType, valueToken)
+		if err != nil {
+			return nil, err
+		}
+		if operatorToken.text == "!=" {
+			return not(condition), nil
+		}
+		return condition, nil
+	default:
+		return rangeCondition(field, fieldType, operatorToken.text, valueToken)
+	}
+}
+
+func (p *filterParser) parseIn(field string, fieldType filterFieldType) (*qdrant.Condition, error) {
+	if _, err := p.expect(tokenLeftParen, "( after IN"); err != nil {
+		return nil, err
+	}
+
+	var keywords []string
+	var integers []int64
+	for {
+		switch fieldType {
+		case filterKeyword:
+			token, err := p.expect(tokenString, "a string")
+			if err != nil {
+				return nil, err
+			}
+			keywords = append(keywords, token.text)
+		case filterInteger:
+			token, err := p.expect(tokenNumber, "an integer")
+			if err != nil {
+				return nil, err
+			}
+			value, err := strconv.ParseInt(token.text, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not an integer", describeToken(token))
+			}
+			integers = append(integers, value)
+		default:
+			return nil, fmt.Errorf("IN is not supported on %s", field)
+		}
+
+		if _, err := p.expect(tokenComma, ","); err != nil {
+			if _, err := p.expect(tokenRightParen, ", or )"); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if fieldType == filterInteger {
+		return qdrant.NewMatchInts(field, integers...), nil
+	}
+	return qdrant.NewMatchKeywords(field, keywords...), nil
+}
+
+func matchCondition(field string, fieldType filterFieldType, value filterToken) (*qdrant.Condition, error) {
+	switch fieldType {
+	case filterKeyword:
+		if value.kind != tokenString {
+			return nil, fmt.Errorf("%s must be compared with a string, got %s", field, describeToken(value))
+		}
+		return qdrant.NewMatchKeyword(field, value.text), nil
+	case filterInteger:
+		integer, err := strconv.ParseInt(value.text, 10, 64)
+		if value.kind != tokenNumber || err != nil {
+			return nil, fmt.Errorf("%s must be compared with an integer, got %s", field, describeToken(value))
+		}
+		return qdrant.NewMatchInt(field, integer), nil
+	default:
+		return nil, fmt.Errorf("%s only supports <, <=, > and >=", field)
+	}
+}
+
+func rangeCondition(field string, fieldType filterFieldType, operator string, value filterToken) (*qdrant.Condition, error) {
+	switch fieldType {
+	case filterInteger:
+		number, err := strconv.ParseFloat(value.text, 64)
+		if value.kind != tokenNumber || err != nil {
+			return nil, fmt.Errorf("%s must be compared with a number, got %s", field, describeToken(value))
+		}
+		r := &qdrant.Range{}
+		switch operator {
+		case "<":
+			r.Lt = &number
+		case "<=":
+			r.Lte = &number
+		case ">":
+			r.Gt = &number
+		case ">=":
+			r.Gte = &number
+		}
+		return qdrant.NewRange(field, r), nil
+	case filterDatetime:
+		t, err := time.Parse(time.RFC3339, value.text)
+		if value.kind != tokenString || err != nil {
+			return nil, fmt.Errorf("%s must be compared with an RFC 3339 time, got %s", field, describeToken(value))
+		}
+		timestamp := timestamppb.New(t)
+		r := &qdrant.DatetimeRange{}
+		switch operator {
+		case "<":
+			r.Lt = timestamp
+		case "<=":
+			r.Lte = timestamp
+		case ">":
+			r.Gt = timestamp
+		case ">=":
+			r.Gte = timestamp
+		}
+		return qdrant.NewDatetimeRange(field, r), nil
+	default:
+		return nil, fmt.Errorf("%s only supports =, != and IN", field)
+	}
+}
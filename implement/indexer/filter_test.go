@@ -0,0 +1,68 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       *qdrant.Condition
+	}{
+		{
+			`content_type = "mutation"`,
+			qdrant.NewMatchKeyword("content_type", "mutation"),
+		},
+		{
+			`api_version IN ("2024-10", '2025-01') and source_order >= 2`,
+			qdrant.NewFilterAsCondition(&qdrant.Filter{Must: []*qdrant.Condition{
+				qdrant.NewMatchKeywords("api_version", "2024-10", "2025-01"),
+				qdrant.NewRange("source_order", &qdrant.Range{Gte: qdrant.PtrOf(2.0)}),
+			}}),
+		},
+		{
+			`breadcrumbs = "billing" OR NOT (section_anchor != "")`,
+			qdrant.NewFilterAsCondition(&qdrant.Filter{Should: []*qdrant.Condition{
+				qdrant.NewMatchKeyword("breadcrumbs", "billing"),
+				not(not(qdrant.NewMatchKeyword("section_anchor", ""))),
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFilter(tt.expression)
+		if err != nil {
+			t.Errorf("parseFilter(%q): %v", tt.expression, err)
+			continue
+		}
+		want := &qdrant.Filter{Must: []*qdrant.Condition{tt.want}}
+		if !proto.Equal(got, want) {
+			t.Errorf("parseFilter(%q) = %v, want %v", tt.expression, got, want)
+		}
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	for _, expression := range []string{
+		`content = "x"`,
+		`content_type = 1`,
+		`source_order = "1"`,
+		`indexed_at = "2024-10-01T00:00:00Z"`,
+		`indexed_at > "yesterday"`,
+		`content_type = "a" AND`,
+		`(content_type = "a"`,
+		`content_type = "a`,
+		`content_type IN ("a" "b")`,
+	} {
+		if _, err := parseFilter(expression); err == nil {
+			t.Errorf("parseFilter(%q) succeeded, want an error", expression)
+		}
+	}
+
+	if filter, err := parseFilter("  "); filter != nil || err != nil {
+		t.Errorf("parseFilter of a blank expression = %v, %v, want nil", filter, err)
+	}
+}
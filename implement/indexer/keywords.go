@@ -0,0 +1,162 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+const (
+	// keywordVectorName is the sparse vector holding the BM25 term weights
+	// of a point. Qdrant applies the IDF part at query time.
+	keywordVectorName = "keywords"
+	// keywordEncoding versions the tokenizer and weighting below. Points
+	// stored with another encoding get their keyword vector rewritten.
+	keywordEncoding = "bm25-v1"
+	// payloadKeywordEncoding is the keywordEncoding a point was stored with.
+	payloadKeywordEncoding = "keyword_encoding"
+
+	bm25K1 = 1.2
+	bm25B  = 0.75
+	// bm25AverageLength is the document length the term frequencies are
+	// normalised against. Qdrant doesn't track it, so it is fixed.
+	bm25AverageLength = 256
+)
+
+var keywordStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "can": true, "for": true, "from": true, "how": true,
+	"if": true, "in": true, "is": true, "it": true, "of": true, "on": true,
+	"or": true, "that": true, "the": true, "this": true, "to": true, "use": true,
+	"was": true, "what": true, "when": true, "with": true, "you": true, "your": true,
+}
+
+// keywordTokens splits text into lowercase terms. Identifiers are kept whole
+// so that exact queries like discountCodeBasicCreate or
+// X-Shopify-Access-Token match, and their parts are added as well.
+func keywordTokens(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-'
+	})
+
+	var tokens []string
+	for _, word := range words {
+		word = strings.Trim(word, "-_")
+		if word == "" {
+			continue
+		}
+
+		parts := identifierParts(word)
+		if len(parts) > 1 {
+			tokens = appendKeyword(tokens, strings.ToLower(word))
+		}
+		for _, part := range parts {
+			tokens = appendKeyword(tokens, strings.ToLower(part))
+		}
+	}
+
+	return tokens
+}
+
+func appendKeyword(tokens []string, token string) []string {
+	if len(token) < 2 || keywordStopWords[token] {
+		return tokens
+	}
+
+	return append(tokens, token)
+}
+
+// identifierParts splits a word on dashes, underscores and camelCase humps.
+func identifierParts(word string) []string {
+	var parts []string
+	for _, chunk := range strings.FieldsFunc(word, func(r rune) bool { return r == '-' || r == '_' }) {
+		runes := []rune(chunk)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			lowerToUpper := unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i])
+			// Split "HTTPServer" into "HTTP" and "Server".
+			acronymEnd := i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i+1])
+			if lowerToUpper || acronymEnd {
+				parts = append(parts, string(runes[start:i]))
+				start = i
+			}
+		}
+		parts = append(parts, string(runes[start:]))
+	}
+
+	return parts
+}
+
+func keywordIndex(token string) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(token))
+	return hash.Sum32()
+}
+
+// sparseFromWeights turns term weights into a sparse vector sorted by index.
+// Hash collisions are merged.
+func sparseFromWeights(weights map[string]float32) ([]uint32, []float32) {
+	byIndex := make(map[uint32]float32, len(weights))
+	for token, weight := range weights {
+		byIndex[keywordIndex(token)] += weight
+	}
+
+	indices := make([]uint32, 0, len(byIndex))
+	for index := range byIndex {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	values := make([]float32, len(indices))
+	for i, index := range indices {
+		values[i] = byIndex[index]
+	}
+
+	return indices, values
+}
+
+// keywordDocumentVector returns the BM25 term frequency weights of text.
+func keywordDocumentVector(text string) ([]uint32, []float32) {
+	tokens := keywordTokens(text)
+
+	counts := make(map[string]float32)
+	for _, token := range tokens {
+		counts[token]++
+	}
+
+	lengthNorm := 1 - bm25B + bm25B*float32(len(tokens))/bm25AverageLength
+	weights := make(map[string]float32, len(counts))
+	for token, count := range counts {
+		weights[token] = count * (bm25K1 + 1) / (count + bm25K1*lengthNorm)
+	}
+
+	return sparseFromWeights(weights)
+}
+
+// keywordQueryVector weighs every distinct term of the query equally.
+func keywordQueryVector(query string) ([]uint32, []float32) {
+	weights := make(map[string]float32)
+	for _, token := range keywordTokens(query) {
+		weights[token] = 1
+	}
+
+	return sparseFromWeights(weights)
+}
+
+// collectionHasKeywords reports whether the collection was created with the
+// keyword sparse vector. Collections created before hybrid search only hold
+// dense vectors until they are rebuilt.
+func collectionHasKeywords(ctx context.Context, client *qdrant.Client, collection string) (bool, error) {
+	info, err := client.GetCollectionInfo(ctx, collection)
+	if err != nil {
+		return false, fmt.Errorf("failed to get collection info: %w", err)
+	}
+
+	_, ok := info.GetConfig().GetParams().GetSparseVectorsConfig().GetMap()[keywordVectorName]
+	return ok, nil
+}
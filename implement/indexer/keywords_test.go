@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+func TestKeywordTokens(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"discountCodeBasicCreate", []string{"discountcodebasiccreate", "discount", "code", "basic", "create"}},
+		{"Set the X-Shopify-Access-Token header", []string{"set", "x-shopify-access-token", "shopify", "access", "token", "header"}},
+		{"HTTPServer", []string{"httpserver", "http", "server"}},
+	}
+
+	for _, tt := range tests {
+		if got := keywordTokens(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("keywordTokens(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestFuseResults(t *testing.T) {
+	point := func(id string, score float32) *qdrant.ScoredPoint {
+		return &qdrant.ScoredPoint{Id: qdrant.NewIDUUID(id), Score: score}
+	}
+
+	vectorPoints := []*qdrant.ScoredPoint{point("a", 0.9), point("b", 0.8), point("c", 0.7)}
+	keywordPoints := []*qdrant.ScoredPoint{point("c", 12), point("d", 9)}
+
+	hits := fuseResults(vectorPoints, keywordPoints)
+
+	var ids []string
+	for _, hit := range hits {
+		ids = append(ids, hit.PointId)
+	}
+	if want := []string{"c", "a", "b", "d"}; !slices.Equal(ids, want) {
+		t.Fatalf("got order %q, want %q", ids, want)
+	}
+	if hits[0].VectorRank != 3 || hits[0].KeywordRank != 1 {
+		t.Errorf("got ranks %d/%d for c, want 3/1", hits[0].VectorRank, hits[0].KeywordRank)
+	}
+}
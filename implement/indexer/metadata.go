@@ -0,0 +1,89 @@
+package indexer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+const (
+	// payloadSectionAnchor is the anchor of a section point, empty on page
+	// points.
+	payloadSectionAnchor = "section_anchor"
+	// payloadBreadcrumbs lists the path segments leading to the page, such
+	// as ["apps", "launch", "billing"].
+	payloadBreadcrumbs = "breadcrumbs"
+	// payloadApiVersion is the API version of reference pages, such as
+	// 2024-10 or unstable, empty on other pages.
+	payloadApiVersion = "api_version"
+	// payloadContentType classifies the page, see pageMetadata.
+	payloadContentType = "content_type"
+	// payloadIndexedAt is when the point was last written, in RFC 3339.
+	payloadIndexedAt = "indexed_at"
+)
+
+var apiVersionPattern = regexp.MustCompile(`^(\d{4}-\d{2}|unstable|latest)$`)
+
+// referenceContentTypes maps the kind segment of API reference URLs to the
+// content type of the page.
+var referenceContentTypes = map[string]string{
+	"queries":       "query",
+	"mutations":     "mutation",
+	"objects":       "object",
+	"input-objects": "input_object",
+	"enums":         "enum",
+	"interfaces":    "interface",
+	"unions":        "union",
+	"scalars":       "scalar",
+	"payloads":      "payload",
+	"resources":     "resource",
+}
+
+// pageMetadata derives the breadcrumbs, API version and content type of a
+// page from its source URL.
+//
+// Pages under /docs/api are "api_reference", or the kind of the reference
+// item for GraphQL and REST items like "mutation" or "resource". Other
+// /docs pages are "guide", and anything else is "other".
+func pageMetadata(sourceUrl string) (breadcrumbs []string, apiVersion string, contentType string) {
+	path, _, _ := strings.Cut(normalizePageUrl(sourceUrl), "#")
+	segments := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+
+	if len(segments) == 0 || segments[0] != "docs" {
+		return nil, "", "other"
+	}
+	segments = segments[1:]
+
+	contentType = "guide"
+	if len(segments) > 0 && segments[0] == "api" {
+		contentType = "api_reference"
+	}
+
+	breadcrumbs = []string{}
+	for i, segment := range segments {
+		if contentType != "guide" && apiVersionPattern.MatchString(segment) {
+			apiVersion = segment
+			continue
+		}
+		// The last segment is the page itself.
+		if i == len(segments)-1 {
+			break
+		}
+		if kind, ok := referenceContentTypes[segment]; ok && contentType != "guide" && apiVersion != "" {
+			contentType = kind
+		}
+		breadcrumbs = append(breadcrumbs, segment)
+	}
+
+	return breadcrumbs, apiVersion, contentType
+}
+
+func newValueStrings(values []string) *qdrant.Value {
+	list := &qdrant.ListValue{}
+	for _, value := range values {
+		list.Values = append(list.Values, qdrant.NewValueString(value))
+	}
+
+	return qdrant.NewValueList(list)
+}
@@ -0,0 +1,29 @@
+package indexer
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPageMetadata(t *testing.T) {
+	tests := []struct {
+		sourceUrl   string
+		breadcrumbs []string
+		apiVersion  string
+		contentType string
+	}{
+		{"/docs/apps/launch/billing/managed-pricing", []string{"apps", "launch", "billing"}, "", "guide"},
+		{"https://shopify.dev/docs/api/admin-graphql/2024-10/mutations/discountCodeBasicCreate", []string{"api", "admin-graphql", "mutations"}, "2024-10", "mutation"},
+		{"/docs/api/admin-rest/unstable/resources/product#get", []string{"api", "admin-rest", "resources"}, "unstable", "resource"},
+		{"/docs/api/storefront", []string{"api"}, "", "api_reference"},
+		{"/changelog/some-change", nil, "", "other"},
+	}
+
+	for _, tt := range tests {
+		breadcrumbs, apiVersion, contentType := pageMetadata(tt.sourceUrl)
+		if !slices.Equal(breadcrumbs, tt.breadcrumbs) || apiVersion != tt.apiVersion || contentType != tt.contentType {
+			t.Errorf("pageMetadata(%q) = %q, %q, %q, want %q, %q, %q", tt.sourceUrl,
+				breadcrumbs, apiVersion, contentType, tt.breadcrumbs, tt.apiVersion, tt.contentType)
+		}
+	}
+}
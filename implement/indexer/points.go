@@ -0,0 +1,107 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+const scrollPageSize = 256
+
+// scrollPoints calls fn with every point of the collection matching filter,
+// one page at a time. A nil filter matches every point.
+func scrollPoints(
+	ctx context.Context,
+	client *qdrant.Client,
+	collection string,
+	filter *qdrant.Filter,
+	withPayload *qdrant.WithPayloadSelector,
+	withVectors bool,
+	fn func([]*qdrant.RetrievedPoint) error,
+) error {
+	limit := uint32(scrollPageSize)
+	var offset *qdrant.PointId
+
+	for {
+		res, err := client.GetPointsClient().Scroll(ctx, &qdrant.ScrollPoints{
+			CollectionName: collection,
+			Filter:         filter,
+			Offset:         offset,
+			Limit:          &limit,
+			WithPayload:    withPayload,
+			WithVectors:    qdrant.NewWithVectors(withVectors),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to scroll points: %w", err)
+		}
+
+		if len(res.Result) > 0 {
+			if err := fn(res.Result); err != nil {
+				return err
+			}
+		}
+
+		if res.NextPageOffset == nil {
+			return nil
+		}
+		offset = res.NextPageOffset
+	}
+}
+
+func (s *IndexerServer) ListUrls(
+	ctx context.Context,
+	req *connect.Request[indexerv1.ListUrlsRequest],
+) (*connect.Response[indexerv1.ListUrlsResponse], error) {
+	urls, err := ListPageUrls(ctx, req.Msg.Prefix, req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(&indexerv1.ListUrlsResponse{
+		Urls: urls,
+	}), nil
+}
+
+// ListPageUrls returns the sorted URLs of the indexed pages starting with
+// prefix, relative to https://shopify.dev. With a filter expression, only
+// pages with a matching point are listed.
+func ListPageUrls(ctx context.Context, prefix string, filterExpression string) ([]string, error) {
+	filter, err := parseFilter(filterExpression)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid filter: %w", err))
+	}
+
+	qdrantClient := getQdrantClient()
+
+	if err := ensureCollectionExists(ctx, qdrantClient); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	prefix = normalizePageUrl(prefix)
+	err = scrollPoints(ctx, qdrantClient, collectionName(), filter, qdrant.NewWithPayloadInclude(payloadPageUrl, "source_url"), false, func(points []*qdrant.RetrievedPoint) error {
+		for _, point := range points {
+			pageUrl := pointPageUrl(point.Payload)
+			if pageUrl != "" && strings.HasPrefix(pageUrl, prefix) {
+				seen[pageUrl] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]string, 0, len(seen))
+	for pageUrl := range seen {
+		urls = append(urls, pageUrl)
+	}
+	sort.Strings(urls)
+
+	return urls, nil
+}
@@ -0,0 +1,108 @@
+package indexer
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+)
+
+const (
+	defaultWorkers               = 16
+	defaultMaxInflightEmbeddings = 8
+	defaultMaxInflightWrites     = 4
+)
+
+// envInt reads a positive integer from the environment, falling back to def.
+func envInt(name string, def int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return def
+	}
+
+	return value
+}
+
+// workerPool runs jobs on a fixed set of goroutines shared by every request.
+type workerPool struct {
+	jobs chan func()
+}
+
+func newWorkerPool(size int) *workerPool {
+	pool := &workerPool{
+		jobs: make(chan func()),
+	}
+
+	for range size {
+		go func() {
+			for job := range pool.jobs {
+				job()
+			}
+		}()
+	}
+
+	return pool
+}
+
+// submit hands job to an idle worker, waiting for one to be free. It fails
+// without running job if ctx is done first.
+func (p *workerPool) submit(ctx context.Context, job func()) error {
+	select {
+	case p.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// getWorkerPool returns the pool page and section embeddings run on, sized
+// by INDEXER_WORKERS.
+var getWorkerPool = sync.OnceValue(func() *workerPool {
+	return newWorkerPool(envInt("INDEXER_WORKERS", defaultWorkers))
+})
+
+// semaphore limits how many operations run at once.
+type semaphore chan struct{}
+
+// acquire takes a slot, waiting until one is free or ctx is done.
+func (s semaphore) acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s semaphore) release() {
+	<-s
+}
+
+// getWriteSlots limits the writes in flight to the collection across all
+// requests, sized by INDEXER_MAX_INFLIGHT_WRITES.
+var getWriteSlots = sync.OnceValue(func() semaphore {
+	return make(semaphore, envInt("INDEXER_MAX_INFLIGHT_WRITES", defaultMaxInflightWrites))
+})
+
+// limitedEmbeddingModel bounds the embedding calls in flight to the wrapped
+// model.
+type limitedEmbeddingModel struct {
+	EmbeddingModel
+	slots semaphore
+}
+
+func newLimitedEmbeddingModel(model EmbeddingModel, maxInflight int) EmbeddingModel {
+	return &limitedEmbeddingModel{
+		EmbeddingModel: model,
+		slots:          make(semaphore, maxInflight),
+	}
+}
+
+func (m *limitedEmbeddingModel) EmbedContent(ctx context.Context, content string) ([]float32, error) {
+	if err := m.slots.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer m.slots.release()
+
+	return m.EmbeddingModel.EmbedContent(ctx, content)
+}
@@ -0,0 +1,204 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Reranker scores search candidates against the query with a model that is
+// too expensive to run over the whole collection.
+type Reranker interface {
+	// Rerank returns the relevance of every document to query, in order.
+	// Higher is more relevant.
+	Rerank(ctx context.Context, query string, documents []string) ([]float32, error)
+	// RerankerID identifies the reranker in search responses.
+	RerankerID() string
+}
+
+// LexicalReranker scores documents with BM25, computing term statistics
+// over the candidates themselves, and boosts documents that contain the
+// query as a phrase. It needs no external service.
+type LexicalReranker struct{}
+
+func NewLexicalReranker() Reranker {
+	return &LexicalReranker{}
+}
+
+func (r *LexicalReranker) Rerank(ctx context.Context, query string, documents []string) ([]float32, error) {
+	terms := make(map[string]bool)
+	for _, token := range keywordTokens(query) {
+		terms[token] = true
+	}
+	phrase := strings.ToLower(strings.TrimSpace(query))
+
+	counts := make([]map[string]float64, len(documents))
+	lengths := make([]float64, len(documents))
+	documentFrequency := make(map[string]float64)
+	totalLength := 0.0
+	for i, document := range documents {
+		tokens := keywordTokens(document)
+		counts[i] = make(map[string]float64)
+		for _, token := range tokens {
+			if terms[token] {
+				counts[i][token]++
+			}
+		}
+		for term := range counts[i] {
+			documentFrequency[term]++
+		}
+		lengths[i] = float64(len(tokens))
+		totalLength += lengths[i]
+	}
+
+	averageLength := max(totalLength/float64(max(len(documents), 1)), 1)
+	scores := make([]float32, len(documents))
+	for i := range documents {
+		score := 0.0
+		for term, count := range counts[i] {
+			n := float64(len(documents))
+			idf := math.Log(1 + (n-documentFrequency[term]+0.5)/(documentFrequency[term]+0.5))
+			lengthNorm := 1 - bm25B + bm25B*lengths[i]/averageLength
+			score += idf * count * (bm25K1 + 1) / (count + bm25K1*lengthNorm)
+		}
+		if phrase != "" && strings.Contains(strings.ToLower(documents[i]), phrase) {
+			score *= 2
+		}
+		scores[i] = float32(score)
+	}
+
+	return scores, nil
+}
+
+func (r *LexicalReranker) RerankerID() string {
+	return "lexical/bm25"
+}
+
+// RemoteReranker calls a hosted reranking model through the rerank API
+// shared by Cohere, Jina and Voyage compatible services.
+type RemoteReranker struct {
+	client *http.Client
+	url    string
+	apiKey string
+	model  string
+}
+
+func NewRemoteReranker(client *http.Client, url, apiKey, model string) Reranker {
+	return &RemoteReranker{
+		client: client,
+		url:    url,
+		apiKey: apiKey,
+		model:  model,
+	}
+}
+
+type rerankRequest struct {
+	Model     string   `json:"model,omitempty"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+}
+
+type rerankResponse struct {
+	Results []struct {
+		Index          int     `json:"index"`
+		RelevanceScore float32 `json:"relevance_score"`
+	} `json:"results"`
+}
+
+// httpStatusError is a non-2xx response from an HTTP service.
+type httpStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+func (r *RemoteReranker) Rerank(ctx context.Context, query string, documents []string) ([]float32, error) {
+	body, err := json.Marshal(rerankRequest{
+		Model:     r.model,
+		Query:     query,
+		Documents: documents,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+r.apiKey)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call reranker: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("failed to call reranker: %w", &httpStatusError{
+			StatusCode: resp.StatusCode,
+			Body:       strings.TrimSpace(string(message)),
+		})
+	}
+
+	var result rerankResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode reranker response: %w", err)
+	}
+
+	// Documents the service left out of its results rank last.
+	scores := make([]float32, len(documents))
+	for i := range scores {
+		scores[i] = float32(math.Inf(-1))
+	}
+	for _, item := range result.Results {
+		if item.Index < 0 || item.Index >= len(documents) {
+			return nil, fmt.Errorf("reranker returned unknown document index %d", item.Index)
+		}
+		scores[item.Index] = item.RelevanceScore
+	}
+
+	return scores, nil
+}
+
+func (r *RemoteReranker) RerankerID() string {
+	if r.model == "" {
+		return "remote"
+	}
+	return "remote/" + r.model
+}
+
+// getReranker returns the reranker selected by RERANKER: "lexical" (the
+// default), "remote" or "none". It returns nil when reranking is disabled.
+var getReranker = sync.OnceValue(func() Reranker {
+	switch kind := os.Getenv("RERANKER"); kind {
+	case "", "lexical":
+		return NewLexicalReranker()
+	case "remote":
+		url := os.Getenv("RERANKER_URL")
+		if url == "" {
+			panic("RERANKER_URL environment variable is not set")
+		}
+		return NewRemoteReranker(http.DefaultClient, url, os.Getenv("RERANKER_API_KEY"), os.Getenv("RERANKER_MODEL"))
+	case "none":
+		return nil
+	default:
+		log.Printf("unknown RERANKER %q, reranking is disabled", kind)
+		return nil
+	}
+})
@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+)
+
+func TestLexicalReranker(t *testing.T) {
+	documents := []string{
+		"Apps can charge merchants with managed pricing.",
+		"Use discountCodeBasicCreate to create a basic discount code.",
+		"Discounts can be combined with other discounts.",
+	}
+
+	scores, err := NewLexicalReranker().Rerank(context.Background(), "discountCodeBasicCreate", documents)
+	if err != nil {
+		t.Fatalf("Rerank: %v", err)
+	}
+	if !(scores[1] > scores[2] && scores[2] >= scores[0]) {
+		t.Errorf("got scores %v, want the mutation document first", scores)
+	}
+}
+
+func TestRemoteReranker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer key" {
+			t.Errorf("got authorization %q", got)
+		}
+
+		var req rerankRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		if req.Model != "rerank-model" || len(req.Documents) != 2 {
+			t.Errorf("unexpected request %+v", req)
+		}
+
+		w.Write([]byte(`{"results":[{"index":1,"relevance_score":0.9},{"index":0,"relevance_score":0.2}]}`))
+	}))
+	defer server.Close()
+
+	reranker := NewRemoteReranker(server.Client(), server.URL, "key", "rerank-model")
+	scores, err := reranker.Rerank(context.Background(), "query", []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("Rerank: %v", err)
+	}
+	if !slices.Equal(scores, []float32{0.2, 0.9}) {
+		t.Errorf("got scores %v", scores)
+	}
+}
+
+func TestSelectHits(t *testing.T) {
+	hit := func(id, pageUrl, content string, relevance float32) *fusedHit {
+		return &fusedHit{
+			SearchHit: &indexerv1.SearchHit{PointId: id, PageUrl: pageUrl, Content: content},
+			relevance: relevance,
+		}
+	}
+	hits := func() []*fusedHit {
+		return []*fusedHit{
+			hit("page", "/docs/pricing", "managed pricing plans charge merchants monthly billing", 1),
+			hit("section", "/docs/pricing", "managed pricing plans charge merchants", 0.9),
+			hit("other", "/docs/billing", "usage charges through the billing api", 0.8),
+		}
+	}
+	ids := func(hits []*fusedHit) []string {
+		var ids []string
+		for _, hit := range hits {
+			ids = append(ids, hit.PointId)
+		}
+		return ids
+	}
+
+	if got := ids(selectHits(hits(), 2, 0, 0)); !slices.Equal(got, []string{"page", "section"}) {
+		t.Errorf("relevance only: got %q", got)
+	}
+	if got := ids(selectHits(hits(), 2, 0, 1)); !slices.Equal(got, []string{"page", "other"}) {
+		t.Errorf("one hit per page: got %q", got)
+	}
+	if got := ids(selectHits(hits(), 2, 0.5, 0)); !slices.Equal(got, []string{"page", "other"}) {
+		t.Errorf("diversity: got %q", got)
+	}
+}
+
+// reverseReranker scores documents in reverse order of the candidates.
+type reverseReranker struct{}
+
+func (reverseReranker) Rerank(ctx context.Context, query string, documents []string) ([]float32, error) {
+	scores := make([]float32, len(documents))
+	for i := range documents {
+		scores[i] = float32(i)
+	}
+	return scores, nil
+}
+
+func (reverseReranker) RerankerID() string {
+	return "reverse"
+}
+
+func TestRerankHitsLimit(t *testing.T) {
+	for _, limit := range []int{10, maxRerankCandidates + 1, maxSearchLimit} {
+		var hits []*fusedHit
+		for i := range maxSearchLimit * searchCandidateFactor {
+			hits = append(hits, &fusedHit{SearchHit: &indexerv1.SearchHit{PointId: fmt.Sprint(i)}})
+		}
+
+		reranked, err := rerankHits(context.Background(), reverseReranker{}, "query", hits, max(maxRerankCandidates, limit))
+		if err != nil {
+			t.Fatalf("rerankHits: %v", err)
+		}
+		selected := selectHits(reranked, limit, 0, 0)
+		if len(selected) != limit {
+			t.Errorf("limit %d: got %d hits", limit, len(selected))
+		}
+		if want := fmt.Sprint(max(maxRerankCandidates, limit) - 1); selected[0].PointId != want {
+			t.Errorf("limit %d: first hit %s, want %s", limit, selected[0].PointId, want)
+		}
+	}
+}
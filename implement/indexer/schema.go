@@ -0,0 +1,409 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+// CollectionSchema describes the collection the indexer writes to. It is read
+// from the environment by loadCollectionSchema.
+type CollectionSchema struct {
+	Name       string
+	VectorSize uint64
+	Distance   qdrant.Distance
+	// HnswM and HnswEfConstruct tune the vector index. 0 keeps the Qdrant
+	// default and accepts any value on an existing collection.
+	HnswM           uint64
+	HnswEfConstruct uint64
+	// Quantization is "none", "scalar" or "binary".
+	Quantization string
+	// PayloadIndexes maps payload fields to the type they are indexed as.
+	PayloadIndexes map[string]payloadIndexType
+}
+
+// payloadIndexType pairs the field type used to create a payload index with
+// the schema type Qdrant reports for it.
+type payloadIndexType struct {
+	name       string
+	fieldType  qdrant.FieldType
+	schemaType qdrant.PayloadSchemaType
+}
+
+var payloadIndexTypes = []payloadIndexType{
+	{"keyword", qdrant.FieldType_FieldTypeKeyword, qdrant.PayloadSchemaType_Keyword},
+	{"integer", qdrant.FieldType_FieldTypeInteger, qdrant.PayloadSchemaType_Integer},
+	{"float", qdrant.FieldType_FieldTypeFloat, qdrant.PayloadSchemaType_Float},
+	{"bool", qdrant.FieldType_FieldTypeBool, qdrant.PayloadSchemaType_Bool},
+	{"datetime", qdrant.FieldType_FieldTypeDatetime, qdrant.PayloadSchemaType_Datetime},
+	{"text", qdrant.FieldType_FieldTypeText, qdrant.PayloadSchemaType_Text},
+	{"uuid", qdrant.FieldType_FieldTypeUuid, qdrant.PayloadSchemaType_Uuid},
+}
+
+var distances = map[string]qdrant.Distance{
+	"cosine":    qdrant.Distance_Cosine,
+	"euclid":    qdrant.Distance_Euclid,
+	"dot":       qdrant.Distance_Dot,
+	"manhattan": qdrant.Distance_Manhattan,
+}
+
+const (
+	defaultCollectionName = "shopify-doc"
+	// defaultPayloadIndexes indexes the fields the indexer and filter
+	// expressions match on.
+	defaultPayloadIndexes = payloadPageUrl + ":keyword," +
+		payloadSectionAnchor + ":keyword," +
+		payloadBreadcrumbs + ":keyword," +
+		payloadApiVersion + ":keyword," +
+		payloadContentType + ":keyword," +
+		payloadEmbeddingModel + ":keyword," +
+		payloadIndexedAt + ":datetime"
+)
+
+// loadCollectionSchema reads the collection schema from QDRANT_COLLECTION,
+// QDRANT_VECTOR_SIZE, QDRANT_DISTANCE, QDRANT_HNSW_M,
+// QDRANT_HNSW_EF_CONSTRUCT, QDRANT_QUANTIZATION and QDRANT_PAYLOAD_INDEXES.
+// Without QDRANT_VECTOR_SIZE the vector size is the one of the embedding
+// model, probed if it isn't known.
+func loadCollectionSchema() (CollectionSchema, error) {
+	schema := CollectionSchema{
+		Name:           os.Getenv("QDRANT_COLLECTION"),
+		Distance:       qdrant.Distance_Cosine,
+		Quantization:   "none",
+		PayloadIndexes: make(map[string]payloadIndexType),
+	}
+	if schema.Name == "" {
+		schema.Name = defaultCollectionName
+	}
+
+	var err error
+	if schema.VectorSize, err = envUint("QDRANT_VECTOR_SIZE", 0); err != nil {
+		return schema, err
+	}
+	if os.Getenv("QDRANT_VECTOR_SIZE") == "" {
+		size, err := getEmbeddingVectorSize()
+		if err != nil {
+			return schema, err
+		}
+		schema.VectorSize = uint64(size)
+	}
+	if schema.VectorSize == 0 {
+		return schema, errors.New("QDRANT_VECTOR_SIZE must be positive")
+	}
+	if schema.HnswM, err = envUint("QDRANT_HNSW_M", 0); err != nil {
+		return schema, err
+	}
+	if schema.HnswEfConstruct, err = envUint("QDRANT_HNSW_EF_CONSTRUCT", 0); err != nil {
+		return schema, err
+	}
+
+	if value := os.Getenv("QDRANT_DISTANCE"); value != "" {
+		distance, ok := distances[strings.ToLower(value)]
+		if !ok {
+			return schema, fmt.Errorf("QDRANT_DISTANCE %q is not one of cosine, euclid, dot or manhattan", value)
+		}
+		schema.Distance = distance
+	}
+
+	if value := os.Getenv("QDRANT_QUANTIZATION"); value != "" {
+		switch value = strings.ToLower(value); value {
+		case "none", "scalar", "binary":
+			schema.Quantization = value
+		default:
+			return schema, fmt.Errorf("QDRANT_QUANTIZATION %q is not one of none, scalar or binary", value)
+		}
+	}
+
+	indexes, ok := os.LookupEnv("QDRANT_PAYLOAD_INDEXES")
+	if !ok {
+		indexes = defaultPayloadIndexes
+	}
+	for _, entry := range strings.Split(indexes, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		field, typeName, _ := strings.Cut(entry, ":")
+		if typeName == "" {
+			typeName = "keyword"
+		}
+		indexType, ok := findPayloadIndexType(typeName)
+		if !ok {
+			return schema, fmt.Errorf("QDRANT_PAYLOAD_INDEXES: unknown index type %q for field %q", typeName, field)
+		}
+		schema.PayloadIndexes[field] = indexType
+	}
+
+	return schema, nil
+}
+
+func envUint(name string, def uint64) (uint64, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return def, nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q is not a non-negative integer", name, value)
+	}
+
+	return n, nil
+}
+
+func findPayloadIndexType(name string) (payloadIndexType, bool) {
+	for _, indexType := range payloadIndexTypes {
+		if indexType.name == strings.ToLower(name) {
+			return indexType, true
+		}
+	}
+
+	return payloadIndexType{}, false
+}
+
+// getCollectionSchema returns the schema read from the environment. Servers
+// call CheckCollection at startup, which reports a bad configuration as an
+// error before this panics on it.
+var getCollectionSchema = sync.OnceValue(func() CollectionSchema {
+	schema, err := loadCollectionSchema()
+	if err != nil {
+		panic(err)
+	}
+	return schema
+})
+
+// collectionName is the name of the collection the indexer reads and writes.
+func collectionName() string {
+	return getCollectionSchema().Name
+}
+
+// CheckCollection creates the collection if it doesn't exist and otherwise
+// checks that it matches the configured schema, creating missing payload
+// indexes. It is meant to run once at startup so a mismatch stops the
+// server instead of failing every request.
+func CheckCollection(ctx context.Context) error {
+	embeddingConfig, err := loadOpenAIEmbeddingConfig()
+	if err == nil {
+		err = embeddingConfig.checkAuth()
+	}
+	if err != nil {
+		return fmt.Errorf("invalid embedding configuration: %w", err)
+	}
+
+	schema, err := loadCollectionSchema()
+	if err != nil {
+		return fmt.Errorf("invalid collection schema: %w", err)
+	}
+
+	client := getQdrantClient()
+	if err := ensureCollectionExists(ctx, client); err != nil {
+		return err
+	}
+
+	info, err := client.GetCollectionInfo(ctx, schema.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get collection info: %w", err)
+	}
+
+	migrate, _ := strconv.ParseBool(os.Getenv("QDRANT_MIGRATE"))
+	if err := checkLiveSchema(schema, info, migrate); err != nil {
+		return err
+	}
+
+	if _, ok := info.GetConfig().GetParams().GetSparseVectorsConfig().GetMap()[keywordVectorName]; !ok {
+		log.Printf("collection %s has no keyword vectors, keyword search is disabled until it is rebuilt", schema.Name)
+	}
+
+	return ensurePayloadIndexes(ctx, client, schema.Name, schema, info)
+}
+
+// checkLiveSchema validates the collection the alias points to against the
+// configured schema. While migrating, the configured schema is the one of
+// the versions Rebuild creates, and a live collection that doesn't match it
+// is served, with a warning, until one of them is activated.
+func checkLiveSchema(schema CollectionSchema, info *qdrant.CollectionInfo, migrate bool) error {
+	err := schema.validate(info)
+	var mismatchErr *SchemaMismatchError
+	if !migrate || !errors.As(err, &mismatchErr) {
+		return err
+	}
+
+	log.Printf("collection %s doesn't match the configured schema: %s; it is served until a rebuild with the configured schema is activated",
+		mismatchErr.Collection, strings.Join(mismatchErr.Mismatches, "; "))
+	return nil
+}
+
+// SchemaMismatchError lists how an existing collection differs from the
+// configured schema.
+type SchemaMismatchError struct {
+	Collection string
+	Mismatches []string
+}
+
+func (e *SchemaMismatchError) Error() string {
+	return fmt.Sprintf("collection %s doesn't match the configured schema: %s; set the QDRANT_* variables to match it, or set QDRANT_MIGRATE=true and rebuild the collection",
+		e.Collection, strings.Join(e.Mismatches, "; "))
+}
+
+// validate compares the schema with the info of an existing collection.
+// Payload indexes that are missing are not a mismatch, they are created.
+func (s CollectionSchema) validate(info *qdrant.CollectionInfo) error {
+	var mismatches []string
+
+	params := info.GetConfig().GetParams()
+	vectorParams := params.GetVectorsConfig().GetParams()
+	if vectorParams == nil {
+		vectorParams = params.GetVectorsConfig().GetParamsMap().GetMap()[""]
+	}
+	if vectorParams == nil {
+		mismatches = append(mismatches, "it has no default dense vector")
+	} else {
+		if vectorParams.Size != s.VectorSize {
+			mismatches = append(mismatches, fmt.Sprintf("vector size is %d, configured %d", vectorParams.Size, s.VectorSize))
+		}
+		if vectorParams.Distance != s.Distance {
+			mismatches = append(mismatches, fmt.Sprintf("distance is %s, configured %s", vectorParams.Distance, s.Distance))
+		}
+	}
+
+	hnsw := info.GetConfig().GetHnswConfig()
+	if s.HnswM != 0 && hnsw.GetM() != s.HnswM {
+		mismatches = append(mismatches, fmt.Sprintf("HNSW m is %d, configured %d", hnsw.GetM(), s.HnswM))
+	}
+	if s.HnswEfConstruct != 0 && hnsw.GetEfConstruct() != s.HnswEfConstruct {
+		mismatches = append(mismatches, fmt.Sprintf("HNSW ef_construct is %d, configured %d", hnsw.GetEfConstruct(), s.HnswEfConstruct))
+	}
+
+	if quantization := quantizationKind(info.GetConfig().GetQuantizationConfig()); quantization != s.Quantization {
+		mismatches = append(mismatches, fmt.Sprintf("quantization is %s, configured %s", quantization, s.Quantization))
+	}
+
+	fields := make([]string, 0, len(s.PayloadIndexes))
+	for field := range s.PayloadIndexes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		existing, ok := info.GetPayloadSchema()[field]
+		if ok && existing.DataType != s.PayloadIndexes[field].schemaType {
+			mismatches = append(mismatches, fmt.Sprintf("payload field %s is indexed as %s, configured %s",
+				field, existing.DataType, s.PayloadIndexes[field].name))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return &SchemaMismatchError{
+			Collection: s.Name,
+			Mismatches: mismatches,
+		}
+	}
+
+	return nil
+}
+
+func quantizationKind(config *qdrant.QuantizationConfig) string {
+	switch {
+	case config.GetScalar() != nil:
+		return "scalar"
+	case config.GetBinary() != nil:
+		return "binary"
+	case config.GetProduct() != nil:
+		return "product"
+	default:
+		return "none"
+	}
+}
+
+func (s CollectionSchema) hnswConfig() *qdrant.HnswConfigDiff {
+	if s.HnswM == 0 && s.HnswEfConstruct == 0 {
+		return nil
+	}
+
+	config := &qdrant.HnswConfigDiff{}
+	if s.HnswM != 0 {
+		config.M = qdrant.PtrOf(s.HnswM)
+	}
+	if s.HnswEfConstruct != 0 {
+		config.EfConstruct = qdrant.PtrOf(s.HnswEfConstruct)
+	}
+
+	return config
+}
+
+func (s CollectionSchema) quantizationConfig() *qdrant.QuantizationConfig {
+	switch s.Quantization {
+	case "scalar":
+		return qdrant.NewQuantizationScalar(&qdrant.ScalarQuantization{
+			Type: qdrant.QuantizationType_Int8,
+		})
+	case "binary":
+		return qdrant.NewQuantizationBinary(&qdrant.BinaryQuantization{})
+	default:
+		return nil
+	}
+}
+
+// createCollection creates a collection named name as described by the
+// schema, with the keyword sparse vector and its payload indexes.
+func (s CollectionSchema) createCollection(ctx context.Context, client *qdrant.Client, name string) error {
+	if err := client.CreateCollection(ctx, &qdrant.CreateCollection{
+		CollectionName: name,
+		VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
+			Size:     s.VectorSize,
+			Distance: s.Distance,
+		}),
+		SparseVectorsConfig: qdrant.NewSparseVectorsConfig(map[string]*qdrant.SparseVectorParams{
+			keywordVectorName: {
+				Modifier: qdrant.Modifier_Idf.Enum(),
+			},
+		}),
+		HnswConfig:         s.hnswConfig(),
+		QuantizationConfig: s.quantizationConfig(),
+	}); err != nil {
+		return err
+	}
+
+	return ensurePayloadIndexes(ctx, client, name, s, nil)
+}
+
+// ensurePayloadIndexes creates the payload indexes of the schema that are not
+// in info on the collection. A nil info creates them all.
+func ensurePayloadIndexes(ctx context.Context, client *qdrant.Client, collection string, schema CollectionSchema, info *qdrant.CollectionInfo) error {
+	for field, indexType := range schema.PayloadIndexes {
+		if _, ok := info.GetPayloadSchema()[field]; ok {
+			continue
+		}
+
+		if _, err := client.CreateFieldIndex(ctx, &qdrant.CreateFieldIndexCollection{
+			CollectionName: collection,
+			Wait:           qdrant.PtrOf(true),
+			FieldName:      field,
+			FieldType:      indexType.fieldType.Enum(),
+		}); err != nil {
+			return fmt.Errorf("failed to create payload index on %s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+// checkVectorSize fails when the embedding model returned vectors of another
+// size than the collection holds, which happens when the model changes.
+func checkVectorSize(vector []float32) error {
+	if size := getCollectionSchema().VectorSize; uint64(len(vector)) != size {
+		return connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("embedding has %d dimensions but collection %s holds %d", len(vector), collectionName(), size))
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+func TestLoadCollectionSchema(t *testing.T) {
+	t.Setenv("QDRANT_DISTANCE", "euclid")
+	t.Setenv("QDRANT_PAYLOAD_INDEXES", "page_url, api_version:keyword, indexed_at:datetime")
+
+	schema, err := loadCollectionSchema()
+	if err != nil {
+		t.Fatalf("loadCollectionSchema: %v", err)
+	}
+	if schema.Name != defaultCollectionName || schema.VectorSize != 3072 || schema.Distance != qdrant.Distance_Euclid {
+		t.Errorf("unexpected schema %+v", schema)
+	}
+	if len(schema.PayloadIndexes) != 3 || schema.PayloadIndexes["indexed_at"].name != "datetime" {
+		t.Errorf("unexpected payload indexes %+v", schema.PayloadIndexes)
+	}
+
+	t.Setenv("QDRANT_DISTANCE", "hamming")
+	if _, err := loadCollectionSchema(); err == nil {
+		t.Error("expected an error for an unknown distance")
+	}
+}
+
+func TestCollectionSchemaValidate(t *testing.T) {
+	schema := CollectionSchema{
+		Name:         "docs",
+		VectorSize:   3072,
+		Distance:     qdrant.Distance_Cosine,
+		Quantization: "none",
+		PayloadIndexes: map[string]payloadIndexType{
+			"page_url": payloadIndexTypes[0],
+		},
+	}
+
+	info := &qdrant.CollectionInfo{
+		Config: &qdrant.CollectionConfig{
+			Params: &qdrant.CollectionParams{
+				VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
+					Size:     3072,
+					Distance: qdrant.Distance_Cosine,
+				}),
+			},
+		},
+	}
+	if err := schema.validate(info); err != nil {
+		t.Errorf("validate: %v", err)
+	}
+
+	info.Config.Params.VectorsConfig = qdrant.NewVectorsConfig(&qdrant.VectorParams{
+		Size:     768,
+		Distance: qdrant.Distance_Euclid,
+	})
+	info.PayloadSchema = map[string]*qdrant.PayloadSchemaInfo{
+		"page_url": {DataType: qdrant.PayloadSchemaType_Text},
+	}
+
+	var mismatchErr *SchemaMismatchError
+	if err := schema.validate(info); !errors.As(err, &mismatchErr) || len(mismatchErr.Mismatches) != 3 {
+		t.Errorf("got %v, want 3 mismatches", err)
+	}
+}
+
+func TestCheckLiveSchema(t *testing.T) {
+	schema := CollectionSchema{
+		Name:         "docs",
+		VectorSize:   3072,
+		Distance:     qdrant.Distance_Cosine,
+		Quantization: "none",
+	}
+	info := &qdrant.CollectionInfo{
+		Config: &qdrant.CollectionConfig{
+			Params: &qdrant.CollectionParams{
+				VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
+					Size:     1536,
+					Distance: qdrant.Distance_Euclid,
+				}),
+			},
+		},
+	}
+
+	var mismatchErr *SchemaMismatchError
+	if err := checkLiveSchema(schema, info, false); !errors.As(err, &mismatchErr) {
+		t.Errorf("got %v, want a mismatch", err)
+	}
+	if err := checkLiveSchema(schema, info, true); err != nil {
+		t.Errorf("got %v while migrating, want the mismatch to be served", err)
+	}
+}
@@ -0,0 +1,365 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 100
+	// searchCandidateFactor is how many candidates each result list fetches
+	// per requested hit, so fusion has enough to work with.
+	searchCandidateFactor = 4
+	// rrfK dampens the weight of the top ranks in reciprocal rank fusion.
+	rrfK = 60
+	// maxRerankCandidates bounds the documents sent to the reranker, unless
+	// more hits are requested.
+	maxRerankCandidates = 64
+)
+
+func (s *IndexerServer) Search(
+	ctx context.Context,
+	req *connect.Request[indexerv1.SearchRequest],
+) (*connect.Response[indexerv1.SearchResponse], error) {
+	query := strings.TrimSpace(req.Msg.Query)
+	if query == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query is required"))
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	if req.Msg.Diversity < 0 || req.Msg.Diversity > 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("diversity must be between 0 and 1"))
+	}
+	if req.Msg.MaxHitsPerPage < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("max_hits_per_page must not be negative"))
+	}
+
+	filter, err := parseFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid filter: %w", err))
+	}
+
+	var reranker Reranker
+	if req.Msg.Rerank {
+		reranker = getReranker()
+		if reranker == nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("reranking is disabled"))
+		}
+	}
+
+	mode := req.Msg.Mode
+	if mode == indexerv1.SearchMode_SEARCH_MODE_UNSPECIFIED {
+		mode = indexerv1.SearchMode_SEARCH_MODE_HYBRID
+	}
+
+	qdrantClient := getQdrantClient()
+
+	if err := ensureCollectionExists(ctx, qdrantClient); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	hasKeywords, err := collectionHasKeywords(ctx, qdrantClient, collectionName())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if !hasKeywords {
+		switch mode {
+		case indexerv1.SearchMode_SEARCH_MODE_KEYWORD:
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the collection has no keyword vectors, rebuild it to enable keyword search"))
+		case indexerv1.SearchMode_SEARCH_MODE_HYBRID:
+			log.Printf("collection %s has no keyword vectors, falling back to vector search", collectionName())
+			mode = indexerv1.SearchMode_SEARCH_MODE_VECTOR
+		}
+	}
+
+	// Fusion, reranking and diversity all pick from more candidates than
+	// they return.
+	candidates := limit
+	if mode == indexerv1.SearchMode_SEARCH_MODE_HYBRID || reranker != nil || req.Msg.Diversity > 0 || req.Msg.MaxHitsPerPage > 0 {
+		candidates = limit * searchCandidateFactor
+	}
+
+	var vectorPoints, keywordPoints []*qdrant.ScoredPoint
+	if mode != indexerv1.SearchMode_SEARCH_MODE_KEYWORD {
+		vectorPoints, err = vectorSearch(ctx, qdrantClient, query, filter, candidates)
+		if err != nil {
+			return nil, connect.NewError(errorCode(err), err)
+		}
+	}
+	if mode != indexerv1.SearchMode_SEARCH_MODE_VECTOR {
+		keywordPoints, err = keywordSearch(ctx, qdrantClient, query, filter, candidates)
+		if err != nil {
+			return nil, connect.NewError(errorCode(err), err)
+		}
+	}
+
+	hits := fuseResults(vectorPoints, keywordPoints)
+	if mode != indexerv1.SearchMode_SEARCH_MODE_HYBRID {
+		// A single list keeps the raw scores of the collection.
+		for _, hit := range hits {
+			hit.Score = hit.rawScore
+		}
+	}
+	for _, hit := range hits {
+		hit.relevance = hit.Score
+	}
+
+	res := &indexerv1.SearchResponse{
+		Mode: mode,
+	}
+
+	if reranker != nil {
+		hits, err = rerankHits(ctx, reranker, query, hits, max(maxRerankCandidates, limit))
+		if err != nil {
+			return nil, connect.NewError(errorCode(err), err)
+		}
+		res.Reranker = reranker.RerankerID()
+	}
+
+	hits = selectHits(hits, limit, req.Msg.Diversity, int(req.Msg.MaxHitsPerPage))
+	for _, hit := range hits {
+		res.Hits = append(res.Hits, hit.SearchHit)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func vectorSearch(ctx context.Context, client *qdrant.Client, query string, filter *qdrant.Filter, limit int) ([]*qdrant.ScoredPoint, error) {
+	vector, err := getEmbeddingModel().EmbedContent(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if err := checkVectorSize(vector); err != nil {
+		return nil, err
+	}
+
+	points, err := client.Query(ctx, &qdrant.QueryPoints{
+		CollectionName: collectionName(),
+		Query:          qdrant.NewQueryDense(vector),
+		Filter:         filter,
+		Limit:          qdrant.PtrOf(uint64(limit)),
+		WithPayload:    qdrant.NewWithPayload(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vectors: %w", err)
+	}
+
+	return points, nil
+}
+
+func keywordSearch(ctx context.Context, client *qdrant.Client, query string, filter *qdrant.Filter, limit int) ([]*qdrant.ScoredPoint, error) {
+	indices, values := keywordQueryVector(query)
+	if len(indices) == 0 {
+		return nil, nil
+	}
+
+	points, err := client.Query(ctx, &qdrant.QueryPoints{
+		CollectionName: collectionName(),
+		Query:          qdrant.NewQuerySparse(indices, values),
+		Using:          qdrant.PtrOf(keywordVectorName),
+		Filter:         filter,
+		Limit:          qdrant.PtrOf(uint64(limit)),
+		WithPayload:    qdrant.NewWithPayload(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query keywords: %w", err)
+	}
+
+	return points, nil
+}
+
+// fusedHit is a hit with the score it had in its result list, before fusion.
+type fusedHit struct {
+	*indexerv1.SearchHit
+	rawScore float32
+	// relevance is the score hits are ordered by: the rerank score when they
+	// were reranked, the search score otherwise.
+	relevance float32
+	// terms is the set of keyword tokens of the hit, built when hits are
+	// compared for diversity.
+	terms map[string]bool
+}
+
+// fuseResults merges ranked result lists with reciprocal rank fusion: each
+// point scores the sum of 1/(rrfK+rank) over the lists it appears in.
+func fuseResults(vectorPoints, keywordPoints []*qdrant.ScoredPoint) []*fusedHit {
+	byId := make(map[string]*fusedHit)
+	var hits []*fusedHit
+
+	add := func(points []*qdrant.ScoredPoint, setRank func(*indexerv1.SearchHit, int32)) {
+		for i, point := range points {
+			id := point.Id.GetUuid()
+			hit, ok := byId[id]
+			if !ok {
+				hit = &fusedHit{
+					SearchHit: &indexerv1.SearchHit{
+						PointId:     id,
+						SourceTitle: point.Payload["source_title"].GetStringValue(),
+						SourceUrl:   point.Payload["source_url"].GetStringValue(),
+						PageUrl:     pointPageUrl(point.Payload),
+						Content:     point.Payload["content"].GetStringValue(),
+					},
+					rawScore: point.Score,
+				}
+				byId[id] = hit
+				hits = append(hits, hit)
+			}
+
+			rank := i + 1
+			setRank(hit.SearchHit, int32(rank))
+			hit.Score += 1 / float32(rrfK+rank)
+		}
+	}
+
+	add(vectorPoints, func(hit *indexerv1.SearchHit, rank int32) { hit.VectorRank = rank })
+	add(keywordPoints, func(hit *indexerv1.SearchHit, rank int32) { hit.KeywordRank = rank })
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Score > hits[j].Score
+	})
+
+	return hits
+}
+
+// rerankHits scores the top candidates with reranker and orders them by the
+// new score. Candidates past maxCandidates are dropped, so it must be at
+// least the number of hits returned.
+func rerankHits(ctx context.Context, reranker Reranker, query string, hits []*fusedHit, maxCandidates int) ([]*fusedHit, error) {
+	if len(hits) > maxCandidates {
+		hits = hits[:maxCandidates]
+	}
+	if len(hits) == 0 {
+		return hits, nil
+	}
+
+	documents := make([]string, len(hits))
+	for i, hit := range hits {
+		documents[i] = hit.SourceTitle + "\n\n" + hit.Content
+	}
+
+	scores, err := reranker.Rerank(ctx, query, documents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rerank: %w", err)
+	}
+	if len(scores) != len(hits) {
+		return nil, fmt.Errorf("reranker returned %d scores for %d documents", len(scores), len(hits))
+	}
+
+	for i, hit := range hits {
+		hit.RerankScore = scores[i]
+		hit.relevance = scores[i]
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].relevance > hits[j].relevance
+	})
+
+	return hits, nil
+}
+
+// selectHits picks up to limit hits with maximal marginal relevance: each
+// pick maximises (1-diversity)*relevance - diversity*similarity, where
+// similarity is to the closest hit already picked. With maxPerPage above 0,
+// no more than maxPerPage hits of the same page are picked.
+func selectHits(hits []*fusedHit, limit int, diversity float32, maxPerPage int) []*fusedHit {
+	if diversity == 0 && maxPerPage == 0 {
+		return hits[:min(limit, len(hits))]
+	}
+
+	// Relevance is scaled to [0, 1] so it weighs against similarity
+	// whatever scored it.
+	low, high := float32(math.Inf(1)), float32(math.Inf(-1))
+	for _, hit := range hits {
+		low = min(low, hit.relevance)
+		high = max(high, hit.relevance)
+	}
+	normalized := func(hit *fusedHit) float32 {
+		if high <= low {
+			return 1
+		}
+		return (hit.relevance - low) / (high - low)
+	}
+
+	remaining := slices.Clone(hits)
+	perPage := make(map[string]int)
+	var selected []*fusedHit
+	for len(selected) < limit && len(remaining) > 0 {
+		best, bestScore := -1, float32(math.Inf(-1))
+		for i, hit := range remaining {
+			if maxPerPage > 0 && perPage[hit.PageUrl] >= maxPerPage {
+				continue
+			}
+
+			similarity := float32(0)
+			if diversity > 0 {
+				for _, picked := range selected {
+					similarity = max(similarity, hitSimilarity(hit, picked))
+				}
+			}
+
+			score := (1-diversity)*normalized(hit) - diversity*similarity
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		hit := remaining[best]
+		remaining = slices.Delete(remaining, best, best+1)
+		selected = append(selected, hit)
+		perPage[hit.PageUrl]++
+	}
+
+	return selected
+}
+
+// hitSimilarity is the overlap coefficient of the keyword tokens of two
+// hits. It is close to 1 when one hit contains the other, as a page point
+// contains each of its sections.
+func hitSimilarity(a, b *fusedHit) float32 {
+	aTerms, bTerms := a.keywordTerms(), b.keywordTerms()
+	if len(aTerms) == 0 || len(bTerms) == 0 {
+		return 0
+	}
+	if len(aTerms) > len(bTerms) {
+		aTerms, bTerms = bTerms, aTerms
+	}
+
+	shared := 0
+	for term := range aTerms {
+		if bTerms[term] {
+			shared++
+		}
+	}
+
+	return float32(shared) / float32(len(aTerms))
+}
+
+func (h *fusedHit) keywordTerms() map[string]bool {
+	if h.terms == nil {
+		h.terms = make(map[string]bool)
+		for _, token := range keywordTokens(h.Content) {
+			h.terms[token] = true
+		}
+	}
+
+	return h.terms
+}
@@ -1,13 +1,14 @@
 package indexer
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
-	"text/template"
+	"time"
 
 	"connectrpc.com/connect"
 	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
@@ -36,218 +37,478 @@ var getQdrantClient = sync.OnceValue(func() *qdrant.Client {
 	return client
 })
 
-const shopifyDocsCollectionName = "shopify-doc"
+func (s *IndexerServer) Index(
+	ctx context.Context,
+	req *connect.Request[indexerv1.IndexRequest],
+) (*connect.Response[indexerv1.IndexResponse], error) {
+	if req.Msg.DocPage == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("doc_page is required"))
+	}
 
-func ensureCollectionExists(ctx context.Context, client *qdrant.Client) error {
-	isCollectionExists, err := client.CollectionExists(ctx, shopifyDocsCollectionName)
+	res, err := IndexDocPage(ctx, req.Msg.DocPage, IndexOptions{
+		Force:      req.Msg.Force,
+		Collection: req.Msg.CollectionVersion,
+		DryRun:     req.Msg.DryRun,
+	})
 	if err != nil {
-		return err
+		return nil, newIndexConnectError(err)
 	}
 
-	if isCollectionExists {
-		return nil
-	}
+	return connect.NewResponse(res), nil
+}
 
-	if err := client.CreateCollection(ctx, &qdrant.CreateCollection{
-		CollectionName: shopifyDocsCollectionName,
-		VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
-			Size:     3072, // gemini models/text-embedding-004
-			Distance: qdrant.Distance_Euclid,
-		}),
-	}); err != nil {
-		return err
-	}
+// IndexOptions controls how IndexDocPage writes a page.
+type IndexOptions struct {
+	// Force re-embeds every point, even the ones whose content is unchanged.
+	Force bool
+	// Collection is the collection version to write to. Empty writes to the
+	// active collection through the alias.
+	Collection string
+	// DryRun returns the points that would be embedded and written without
+	// embedding or writing anything.
+	DryRun bool
+}
 
-	return nil
+// indexPoint is a page or section point prepared for embedding.
+type indexPoint struct {
+	id string
+	// section is nil for the page point.
+	section *extractorv1.DocSection
+	content string
+	// tokens is the number of tokens of content.
+	tokens  int
+	payload map[string]*qdrant.Value
 }
 
-func completeDocContent(ctx context.Context, doc *extractorv1.DocPage) (string, error) {
-	var contentTemplate = `---
-Source title: {{.SourceTitle}}
-Source URL: {{.SourceUrl}}
-Sections:
-{{range .DocSections}}
-  - [{{.SectionTitle}}]({{.SourceUrl}}{{.SectionAnchor}})
-{{end}}
----
-
-{{.ContentMarkdown}}`
-	tmpl, err := template.New("docTemplate").Parse(contentTemplate)
+// IndexDocPage embeds a page and its sections and upserts them into the
+// collection. Points whose content hash and embedding model match the stored
+// ones are not embedded again, and stored points of the page that are no
+// longer part of it are deleted. Sections without content or anchor are
+// skipped and reported in the response.
+//
+// Every embedding is computed before anything is written, and the page is
+// then written in one batch that is rolled back on failure, so a failed call
+// leaves the stored page as it was.
+func IndexDocPage(ctx context.Context, docPage *extractorv1.DocPage, opts IndexOptions) (*indexerv1.IndexResponse, error) {
+	qdrantClient := getQdrantClient()
+
+	collection := opts.Collection
+	switch {
+	case opts.DryRun:
+	case collection == "":
+		collection = collectionName()
+		if err := ensureCollectionExists(ctx, qdrantClient); err != nil {
+			return nil, err
+		}
+	default:
+		if err := checkCollectionVersion(ctx, qdrantClient, collection); err != nil {
+			return nil, err
+		}
+	}
+
+	embeddingModel := getEmbeddingModel()
+	templates := getEmbeddingTemplates()
+
+	breadcrumbs, apiVersion, contentType := pageMetadata(docPage.SourceUrl)
+
+	indexingDocContent, err := templates.RenderPage(PageTemplateData{
+		DocPage:     docPage,
+		Breadcrumbs: breadcrumbs,
+		ApiVersion:  apiVersion,
+		ContentType: contentType,
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to parse template: %w", err)
+		return nil, err
 	}
 
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, doc); err != nil {
-		return "", fmt.Errorf("failed to execute template: %w", err)
+	docUUID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(docPage.SourceUrl))
+	pagePoint := &indexPoint{
+		id:      docUUID.String(),
+		content: indexingDocContent,
+		payload: map[string]*qdrant.Value{
+			"content":            qdrant.NewValueString(docPage.ContentMarkdown),
+			"source_title":       qdrant.NewValueString(docPage.SourceTitle),
+			"source_url":         qdrant.NewValueString(docPage.SourceUrl),
+			payloadPageUrl:       qdrant.NewValueString(docPage.SourceUrl),
+			payloadSectionAnchor: qdrant.NewValueString(""),
+		},
 	}
 
-	return buf.String(), nil
-}
+	response := &indexerv1.IndexResponse{
+		PagePointId: pagePoint.id,
+	}
 
-func compileSectionContent(ctx context.Context, section *extractorv1.DocSection) (string, error) {
-	var contentTemplate = `---
-Source Title: {{.SourceTitle}} / {{.SectionTitle}}
-Source URL: {{.SourceUrl}}{{.SectionAnchor}}
----
+	var sectionPoints []*indexPoint
+	for _, section := range docPage.DocSections {
+		if reason := skipSectionReason(section); reason != "" {
+			response.SkippedSections = append(response.SkippedSections, &indexerv1.SkippedSection{
+				SectionAnchor: section.SectionAnchor,
+				SectionTitle:  section.SectionTitle,
+				Reason:        reason,
+			})
+			response.Points = append(response.Points, &indexerv1.PointResult{
+				SectionAnchor: section.SectionAnchor,
+				SectionTitle:  section.SectionTitle,
+				Status:        indexerv1.PointResult_STATUS_SKIPPED,
+			})
+			continue
+		}
 
-{{.ContentMarkdown}}
-`
+		indexingContent, err := templates.RenderSection(SectionTemplateData{
+			DocSection:  section,
+			Page:        docPage,
+			Breadcrumbs: breadcrumbs,
+			ApiVersion:  apiVersion,
+			ContentType: contentType,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile section content: %w", err)
+		}
 
-	tmpl, err := template.New("sectionContent").Parse(contentTemplate)
+		sectionUUID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(section.SourceUrl+section.SectionAnchor))
+		sectionPoints = append(sectionPoints, &indexPoint{
+			id:      sectionUUID.String(),
+			section: section,
+			content: indexingContent,
+			payload: map[string]*qdrant.Value{
+				"content":            qdrant.NewValueString(section.ContentMarkdown),
+				"source_title":       qdrant.NewValueString(section.SourceTitle + "/" + section.SectionTitle),
+				"source_url":         qdrant.NewValueString(section.SourceUrl + section.SectionAnchor),
+				"source_order":       qdrant.NewValueInt(int64(section.Order)),
+				payloadPageUrl:       qdrant.NewValueString(docPage.SourceUrl),
+				payloadSectionAnchor: qdrant.NewValueString(section.SectionAnchor),
+			},
+		})
+		response.SectionPointIds = append(response.SectionPointIds, sectionUUID.String())
+	}
+
+	// A dry run renders the points as they would be written to a collection
+	// created with the current schema, which has keyword vectors.
+	hasKeywords := true
+	if !opts.DryRun {
+		hasKeywords, err = collectionHasKeywords(ctx, qdrantClient, collection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	modelID := embeddingModel.ModelID()
+	tokenizer := getTokenizer()
+	indexedAt := time.Now().UTC().Format(time.RFC3339)
+	allPoints := append([]*indexPoint{pagePoint}, sectionPoints...)
+	for _, point := range allPoints {
+		point.tokens = tokenizer.CountTokens(point.content)
+		point.payload[payloadBreadcrumbs] = newValueStrings(breadcrumbs)
+		point.payload[payloadApiVersion] = qdrant.NewValueString(apiVersion)
+		point.payload[payloadContentType] = qdrant.NewValueString(contentType)
+		point.payload[payloadIndexedAt] = qdrant.NewValueString(indexedAt)
+		point.payload[payloadContentHash] = qdrant.NewValueString(contentHash(point.content))
+		point.payload[payloadEmbeddingModel] = qdrant.NewValueString(modelID)
+		if hasKeywords {
+			point.payload[payloadKeywordEncoding] = qdrant.NewValueString(keywordEncoding)
+		}
+	}
+
+	response.TokensExact = tokenizer.Exact()
+	if opts.DryRun {
+		return dryRunResponse(response, allPoints)
+	}
+
+	changes := make(map[string]pointChange, len(allPoints))
+	if !opts.Force {
+		changes, err = diffStoredPoints(ctx, qdrantClient, collection, allPoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stalePoints, err := findStalePoints(ctx, qdrantClient, collection, docPage.SourceUrl, allPoints)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse template: %w", err)
+		return nil, err
 	}
 
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, section); err != nil {
-		return "", fmt.Errorf("failed to execute template: %w", err)
+	// Phase one: embed every point that needs it. Nothing is written until
+	// all embeddings succeeded.
+	var toEmbed []*indexPoint
+	for _, point := range allPoints {
+		if changes[point.id] == pointEmbed {
+			toEmbed = append(toEmbed, point)
+		}
 	}
 
-	return buf.String(), nil
-}
+	vectors, cached, embedErrs := embedPoints(ctx, embeddingModel, toEmbed)
 
-func (s *IndexerServer) Index(
-	ctx context.Context,
-	req *connect.Request[indexerv1.IndexRequest],
-) (*connect.Response[indexerv1.IndexResponse], error) {
+	// Vectors served from the embedding cache weren't sent to the provider.
+	for _, point := range toEmbed {
+		if !cached[point.id] {
+			response.TokensEmbedded += int64(point.tokens)
+		}
+	}
+	response.EstimatedCostUsd = estimateCost(response.TokensEmbedded, embeddingPricePerMillionTokens())
 
-	qdrantClient := getQdrantClient()
+	if len(embedErrs) > 0 {
+		return nil, newPointsError(response, allPoints, changes, stalePoints, embedErrs)
+	}
 
-	if err := ensureCollectionExists(ctx, qdrantClient); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	// Phase two: write the page, its sections and the pruning in one batch.
+	write := &pageWrite{
+		deletes: stalePoints,
+	}
+	for _, point := range toEmbed {
+		write.upserts = append(write.upserts, point.pointStruct(vectors[point.id], hasKeywords))
+	}
+	for _, point := range allPoints {
+		// Points whose dense vector is still valid only need their payload,
+		// and maybe their keyword vector, rewritten
+		switch changes[point.id] {
+		case pointKeywords:
+			write.vectorUpdates = append(write.vectorUpdates, point.keywordVectors())
+			write.payloadUpdates = append(write.payloadUpdates, point)
+		case pointPayload:
+			write.payloadUpdates = append(write.payloadUpdates, point)
+		}
 	}
 
+	if err := applyPageWrite(ctx, qdrantClient, collection, write); err != nil {
+		writeErrs := make(map[string]error)
+		for _, point := range allPoints {
+			if changes[point.id] != pointUnchanged {
+				writeErrs[point.id] = err
+			}
+		}
+		return nil, newPointsError(response, allPoints, changes, stalePoints, writeErrs)
+	}
 
-	embeddingModel := getEmbeddingModel()
+	var skipped int
+	for _, change := range changes {
+		if change == pointUnchanged {
+			skipped++
+		}
+	}
 
-	indexingDocContent, err := completeDocContent(ctx, req.Msg.DocPage)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	appendPointResults(response, allPoints, changes, stalePoints, nil)
+	response.Success = true
+	response.PointsSkipped = int32(skipped)
+	response.PointsUpdated = int32(len(allPoints) - skipped)
+	response.PointsIndexed = int32(len(allPoints))
+	response.PointsPruned = int32(len(stalePoints))
+
+	return response, nil
+}
+
+// appendPointResults adds the outcome of every point to the response. When
+// errs is not empty the page was not written: points with an error failed
+// and the other points that had something to write were aborted.
+func appendPointResults(
+	response *indexerv1.IndexResponse,
+	points []*indexPoint,
+	changes map[string]pointChange,
+	stalePoints []*qdrant.PointId,
+	errs map[string]error,
+) {
+	failed := len(errs) > 0
+
+	for _, point := range points {
+		result := &indexerv1.PointResult{
+			PointId:    point.id,
+			TokenCount: int32(point.tokens),
+		}
+		if point.section != nil {
+			result.SectionAnchor = point.section.SectionAnchor
+			result.SectionTitle = point.section.SectionTitle
+		}
+
+		switch {
+		case changes[point.id] == pointUnchanged:
+			result.Status = indexerv1.PointResult_STATUS_UNCHANGED
+		case errs[point.id] != nil:
+			result.Status = indexerv1.PointResult_STATUS_FAILED
+			result.ErrorCode = errorCode(errs[point.id]).String()
+			result.ErrorMessage = errs[point.id].Error()
+		case failed:
+			result.Status = indexerv1.PointResult_STATUS_ABORTED
+		case changes[point.id] == pointPayload, changes[point.id] == pointKeywords:
+			result.Status = indexerv1.PointResult_STATUS_PAYLOAD_UPDATED
+		default:
+			result.Status = indexerv1.PointResult_STATUS_UPDATED
+		}
+
+		response.Points = append(response.Points, result)
 	}
 
-	res, err := embeddingModel.EmbedContent(ctx, indexingDocContent)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	for _, id := range stalePoints {
+		status := indexerv1.PointResult_STATUS_PRUNED
+		if failed {
+			status = indexerv1.PointResult_STATUS_ABORTED
+		}
+		response.Points = append(response.Points, &indexerv1.PointResult{
+			PointId: id.GetUuid(),
+			Status:  status,
+		})
 	}
+}
 
-	docUUID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(req.Msg.DocPage.SourceUrl))
+// newPointsError builds the *IndexError for a page that was not written
+// because some of its points failed.
+func newPointsError(
+	response *indexerv1.IndexResponse,
+	points []*indexPoint,
+	changes map[string]pointChange,
+	stalePoints []*qdrant.PointId,
+	errs map[string]error,
+) *IndexError {
+	appendPointResults(response, points, changes, stalePoints, errs)
+
+	var (
+		code     connect.Code
+		messages []error
+	)
+	for _, point := range points {
+		err, ok := errs[point.id]
+		if !ok {
+			continue
+		}
+		if code == 0 {
+			code = errorCode(err)
+		}
+		messages = append(messages, err)
+	}
 
-	// Prepare the point for upsert
-	point := &qdrant.PointStruct{
-		Id: &qdrant.PointId{
-			PointIdOptions: &qdrant.PointId_Uuid{
-				Uuid: docUUID.String(),
-			},
-		},
-		Vectors: &qdrant.Vectors{
-			VectorsOptions: &qdrant.Vectors_Vector{
-				Vector: &qdrant.Vector{
-					Data: res,
-				},
-			},
-		},
-		Payload: map[string]*qdrant.Value{
-			"content":      qdrant.NewValueString(req.Msg.DocPage.ContentMarkdown),
-			"source_title": qdrant.NewValueString(req.Msg.DocPage.SourceTitle),
-			"source_url":   qdrant.NewValueString(req.Msg.DocPage.SourceUrl),
-		},
+	return &IndexError{
+		Code:     code,
+		Response: response,
+		err:      fmt.Errorf("failed to index %d of %d points: %w", len(errs), len(points), errors.Join(messages...)),
+	}
+}
+
+// embedPoints embeds the content of every point on the shared worker pool
+// and returns the vectors by point ID, and which of them came from the
+// embedding cache. On the first failure the remaining points are canceled;
+// the returned errors only hold the points that failed on their own.
+func embedPoints(ctx context.Context, embeddingModel EmbeddingModel, points []*indexPoint) (map[string][]float32, map[string]bool, map[string]error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type embedResult struct {
+		pointId string
+		vector  []float32
+		cached  bool
+		err     error
 	}
 
 	// Create a WaitGroup to wait for all workers to finish
 	var wg sync.WaitGroup
 	// Create a channel to collect results and errors
-	resultChan := make(chan error, len(req.Msg.DocPage.DocSections))
+	resultChan := make(chan embedResult, len(points))
 
-	// Worker function to process each section
-	processSectionWorker := func(section *extractorv1.DocSection) {
+	// Worker function to embed each point
+	embedWorker := func(point *indexPoint) {
 		defer wg.Done()
 
-		indexingContent, err := compileSectionContent(ctx, section)
-		if err != nil {
-			resultChan <- fmt.Errorf("failed to compile section content: %w", err)
+		// The request may have been canceled while the job was queued.
+		if err := ctx.Err(); err != nil {
+			resultChan <- embedResult{pointId: point.id, err: err}
 			return
 		}
 
-		res, err := embeddingModel.EmbedContent(ctx, indexingContent)
+		var cached bool
+		res, err := embeddingModel.EmbedContent(withCacheHit(ctx, &cached), point.content)
 		if err != nil {
-			resultChan <- fmt.Errorf("failed to embed content: %w", err)
+			resultChan <- embedResult{pointId: point.id, err: fmt.Errorf("failed to embed content: %w", err)}
 			return
 		}
-
-		sectionUUID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(section.SourceUrl+section.SectionAnchor))
-		point := &qdrant.PointStruct{
-			Id: &qdrant.PointId{
-				PointIdOptions: &qdrant.PointId_Uuid{
-					Uuid: sectionUUID.String(),
-				},
-			},
-			Vectors: &qdrant.Vectors{
-				VectorsOptions: &qdrant.Vectors_Vector{
-					Vector: &qdrant.Vector{
-						Data: res,
-					},
-				},
-			},
-			Payload: map[string]*qdrant.Value{
-				"content":      qdrant.NewValueString(section.ContentMarkdown),
-				"source_title": qdrant.NewValueString(section.SourceTitle + "/" + section.SectionTitle),
-				"source_url":   qdrant.NewValueString(section.SourceUrl + section.SectionAnchor),
-				"source_order": qdrant.NewValueInt(int64(section.Order)),
-			},
-		}
-
-		_, err = qdrantClient.Upsert(ctx, &qdrant.UpsertPoints{
-			CollectionName: shopifyDocsCollectionName,
-			Points:         []*qdrant.PointStruct{point},
-		})
-		if err != nil {
-			resultChan <- fmt.Errorf("failed to upsert point: %w", err)
+		if err := checkVectorSize(res); err != nil {
+			resultChan <- embedResult{pointId: point.id, err: err}
 			return
 		}
 
-		resultChan <- nil
-	}
-
-	// Start a goroutine for each section
-	for _, section := range req.Msg.DocPage.DocSections {
-		wg.Add(1)
-		go processSectionWorker(section)
+		resultChan <- embedResult{pointId: point.id, vector: res, cached: cached}
 	}
 
-	// Close the result channel when all workers are done
+	// Queue each point on the shared worker pool, and close the result
+	// channel when all workers are done
 	go func() {
+		pool := getWorkerPool()
+		for _, point := range points {
+			wg.Add(1)
+			if err := pool.submit(ctx, func() { embedWorker(point) }); err != nil {
+				wg.Done()
+				resultChan <- embedResult{pointId: point.id, err: err}
+			}
+		}
+
 		wg.Wait()
 		close(resultChan)
 	}()
 
 	// Collect and process results
-	var errors []error
-	for err := range resultChan {
-		if err != nil {
-			errors = append(errors, err)
+	vectors := make(map[string][]float32, len(points))
+	cached := make(map[string]bool)
+	errs := make(map[string]error)
+	for result := range resultChan {
+		if result.err != nil {
+			// Points canceled because of an earlier failure are aborted,
+			// not failed.
+			if len(errs) == 0 || !errors.Is(result.err, context.Canceled) {
+				errs[result.pointId] = result.err
+			}
+			// The page is not written if any point fails, stop the others.
+			cancel()
+			continue
+		}
+		vectors[result.pointId] = result.vector
+		if result.cached {
+			cached[result.pointId] = true
 		}
 	}
 
-	// Handle errors if any
-	if len(errors) > 0 {
-		errorMsg := "failed to process sections:"
-		for _, err := range errors {
-			errorMsg += "\n" + err.Error()
+	return vectors, cached, errs
+}
+
+// pointStruct builds the point to upsert. With keywords, the dense vector is
+// stored as the default vector next to the keyword sparse vector.
+func (p *indexPoint) pointStruct(vector []float32, keywords bool) *qdrant.PointStruct {
+	if !keywords {
+		return &qdrant.PointStruct{
+			Id:      qdrant.NewIDUUID(p.id),
+			Vectors: qdrant.NewVectorsDense(vector),
+			Payload: p.payload,
 		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf(errorMsg))
 	}
 
-	// Perform the upsert operation
-	
-	if _, err := qdrantClient.Upsert(ctx, &qdrant.UpsertPoints{
-		CollectionName: shopifyDocsCollectionName,
-		Points:         []*qdrant.PointStruct{point},
-	}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to upsert point: %w", err))
+	indices, values := keywordDocumentVector(p.content)
+	return &qdrant.PointStruct{
+		Id: qdrant.NewIDUUID(p.id),
+		Vectors: qdrant.NewVectorsMap(map[string]*qdrant.Vector{
+			"":                qdrant.NewVectorDense(vector),
+			keywordVectorName: qdrant.NewVectorSparse(indices, values),
+		}),
+		Payload: p.payload,
 	}
+}
 
-	return connect.NewResponse(&indexerv1.IndexResponse{
-		Success: true,
-	}), nil
+// keywordVectors returns the keyword sparse vector update of the point.
+func (p *indexPoint) keywordVectors() *qdrant.PointVectors {
+	indices, values := keywordDocumentVector(p.content)
+	return &qdrant.PointVectors{
+		Id: qdrant.NewIDUUID(p.id),
+		Vectors: qdrant.NewVectorsMap(map[string]*qdrant.Vector{
+			keywordVectorName: qdrant.NewVectorSparse(indices, values),
+		}),
+	}
+}
+
+// skipSectionReason returns why a section should not be indexed, or an empty
+// string if it should be.
+func skipSectionReason(section *extractorv1.DocSection) string {
+	switch {
+	case section.SectionAnchor == "":
+		// The point ID is derived from the anchor, so a section without one
+		// would overwrite the page point.
+		return "missing section anchor"
+	case strings.TrimSpace(section.ContentMarkdown) == "":
+		return "empty content"
+	default:
+		return ""
+	}
 }
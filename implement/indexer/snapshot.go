@@ -0,0 +1,373 @@
+package indexer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+// A snapshot is a gzip-compressed JSON Lines file: a SnapshotHeader line,
+// then one SnapshotPoint per line. It holds the vectors, so a snapshot can
+// be loaded into another environment without embedding anything again.
+
+const (
+	snapshotFormat  = "shopify-doc-extractor/index-snapshot"
+	snapshotVersion = 1
+	// snapshotBatchSize is how many points are written to the store at once
+	// on import.
+	snapshotBatchSize = 128
+)
+
+// SnapshotHeader describes the vectors of a snapshot.
+type SnapshotHeader struct {
+	Format     string `json:"format"`
+	Version    int    `json:"version"`
+	VectorSize uint64 `json:"vector_size"`
+	Distance   string `json:"distance"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// SnapshotPoint is a point with its vectors and payload.
+type SnapshotPoint struct {
+	ID     string         `json:"id"`
+	Vector snapshotVector `json:"vector"`
+	// Keywords is the keyword sparse vector, nil for points stored without
+	// one.
+	Keywords *SparseVector  `json:"keywords,omitempty"`
+	Payload  map[string]any `json:"payload"`
+}
+
+type SparseVector struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+// snapshotVector is encoded as base64 of little-endian float32s, which is
+// exact and a third of the size of a JSON array.
+type snapshotVector []float32
+
+func (v snapshotVector) MarshalJSON() ([]byte, error) {
+	data := make([]byte, len(v)*4)
+	for i, value := range v {
+		binary.LittleEndian.PutUint32(data[i*4:], math.Float32bits(value))
+	}
+
+	return json.Marshal(data)
+}
+
+func (v *snapshotVector) UnmarshalJSON(text []byte) error {
+	var data []byte
+	if err := json.Unmarshal(text, &data); err != nil {
+		return err
+	}
+	if len(data)%4 != 0 {
+		return errors.New("vector length is not a multiple of 4 bytes")
+	}
+
+	*v = make(snapshotVector, len(data)/4)
+	for i := range *v {
+		(*v)[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+	return nil
+}
+
+// PointStore is a vector store that snapshots are exported from and
+// imported into.
+type PointStore interface {
+	// Describe returns the size and distance of the dense vectors.
+	Describe(ctx context.Context) (vectorSize uint64, distance string, err error)
+	// ScanPoints calls fn with every point of the store, a batch at a time.
+	ScanPoints(ctx context.Context, fn func([]*SnapshotPoint) error) error
+	// UpsertPoints writes points, replacing the ones with the same ID.
+	UpsertPoints(ctx context.Context, points []*SnapshotPoint) error
+}
+
+// ExportIndex writes every point of store to w as a snapshot and returns the
+// number of points written.
+func ExportIndex(ctx context.Context, store PointStore, w io.Writer) (int, error) {
+	vectorSize, distance, err := store.Describe(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+	encoder.SetEscapeHTML(false)
+
+	err = encoder.Encode(SnapshotHeader{
+		Format:     snapshotFormat,
+		Version:    snapshotVersion,
+		VectorSize: vectorSize,
+		Distance:   distance,
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = store.ScanPoints(ctx, func(points []*SnapshotPoint) error {
+		for _, point := range points {
+			if err := encoder.Encode(point); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+
+	return count, gz.Close()
+}
+
+// ImportIndex loads a snapshot into store and returns the number of points
+// written. The snapshot must have the vector size and distance of the store,
+// and have been embedded with the configured embedding model, or queries
+// would be compared with vectors of another model.
+func ImportIndex(ctx context.Context, store PointStore, r io.Reader) (int, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("not a snapshot: %w", err)
+	}
+	defer gz.Close()
+
+	reader := bufio.NewReader(gz)
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	var header SnapshotHeader
+	if err := decoder.Decode(&header); err != nil {
+		return 0, fmt.Errorf("failed to read snapshot header: %w", err)
+	}
+	if header.Format != snapshotFormat || header.Version != snapshotVersion {
+		return 0, fmt.Errorf("unsupported snapshot format %s version %d", header.Format, header.Version)
+	}
+
+	vectorSize, distance, err := store.Describe(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if header.VectorSize != vectorSize || !strings.EqualFold(header.Distance, distance) {
+		return 0, fmt.Errorf("the snapshot has %d-dimensional %s vectors, the store expects %d-dimensional %s vectors",
+			header.VectorSize, header.Distance, vectorSize, distance)
+	}
+
+	config, err := loadOpenAIEmbeddingConfig()
+	if err != nil {
+		return 0, err
+	}
+	modelID := (&OpenAIEmbeddingModel{model: config.Model}).ModelID()
+
+	var count int
+	var batch []*SnapshotPoint
+	for {
+		point := &SnapshotPoint{}
+		err := decoder.Decode(point)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("failed to read point %d: %w", count+len(batch)+1, err)
+		}
+
+		if uint64(len(point.Vector)) != vectorSize {
+			return count, fmt.Errorf("point %s has %d dimensions, expected %d", point.ID, len(point.Vector), vectorSize)
+		}
+		if model, _ := point.Payload[payloadEmbeddingModel].(string); model != "" && model != modelID {
+			return count, fmt.Errorf("point %s was embedded with %s, the configured model is %s", point.ID, model, modelID)
+		}
+		point.Payload = normalizeNumbers(point.Payload).(map[string]any)
+
+		batch = append(batch, point)
+		if len(batch) == snapshotBatchSize {
+			if err := store.UpsertPoints(ctx, batch); err != nil {
+				return count, err
+			}
+			count += len(batch)
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := store.UpsertPoints(ctx, batch); err != nil {
+			return count, err
+		}
+		count += len(batch)
+	}
+
+	return count, nil
+}
+
+// normalizeNumbers replaces the json.Numbers of a decoded payload with int64
+// for integers and float64 otherwise, so integer fields stay integers.
+func normalizeNumbers(value any) any {
+	switch value := value.(type) {
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			return n
+		}
+		f, _ := value.Float64()
+		return f
+	case map[string]any:
+		for key, element := range value {
+			value[key] = normalizeNumbers(element)
+		}
+		if value == nil {
+			return map[string]any{}
+		}
+		return value
+	case []any:
+		for i, element := range value {
+			value[i] = normalizeNumbers(element)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// payloadValue converts a payload value to the Go value it is written to a
+// snapshot as.
+func payloadValue(value *qdrant.Value) any {
+	switch kind := value.GetKind().(type) {
+	case *qdrant.Value_BoolValue:
+		return kind.BoolValue
+	case *qdrant.Value_IntegerValue:
+		return kind.IntegerValue
+	case *qdrant.Value_DoubleValue:
+		return kind.DoubleValue
+	case *qdrant.Value_StringValue:
+		return kind.StringValue
+	case *qdrant.Value_ListValue:
+		list := make([]any, 0, len(kind.ListValue.GetValues()))
+		for _, element := range kind.ListValue.GetValues() {
+			list = append(list, payloadValue(element))
+		}
+		return list
+	case *qdrant.Value_StructValue:
+		fields := make(map[string]any, len(kind.StructValue.GetFields()))
+		for key, element := range kind.StructValue.GetFields() {
+			fields[key] = payloadValue(element)
+		}
+		return fields
+	default:
+		return nil
+	}
+}
+
+type qdrantPointStore struct {
+	client     *qdrant.Client
+	collection string
+	// hasKeywords is whether the collection has keyword vectors, nil until
+	// the first upsert.
+	hasKeywords *bool
+}
+
+// NewQdrantPointStore returns the Qdrant collection or collection version
+// named collection as a PointStore. An empty name is the active collection.
+func NewQdrantPointStore(collection string) PointStore {
+	if collection == "" {
+		collection = collectionName()
+	}
+
+	return &qdrantPointStore{client: getQdrantClient(), collection: collection}
+}
+
+func (s *qdrantPointStore) Describe(ctx context.Context) (uint64, string, error) {
+	info, err := s.client.GetCollectionInfo(ctx, s.collection)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to get collection info: %w", err)
+	}
+
+	params := info.GetConfig().GetParams().GetVectorsConfig().GetParams()
+	if params == nil {
+		params = info.GetConfig().GetParams().GetVectorsConfig().GetParamsMap().GetMap()[""]
+	}
+
+	return params.GetSize(), params.GetDistance().String(), nil
+}
+
+func (s *qdrantPointStore) ScanPoints(ctx context.Context, fn func([]*SnapshotPoint) error) error {
+	return scrollPoints(ctx, s.client, s.collection, nil, qdrant.NewWithPayload(true), true, func(points []*qdrant.RetrievedPoint) error {
+		batch := make([]*SnapshotPoint, 0, len(points))
+		for _, point := range points {
+			payload := make(map[string]any, len(point.Payload))
+			for key, value := range point.Payload {
+				payload[key] = payloadValue(value)
+			}
+
+			snapshotPoint := &SnapshotPoint{
+				ID:      point.GetId().GetUuid(),
+				Vector:  denseVector(point.GetVectors()),
+				Payload: payload,
+			}
+			if keywords := point.GetVectors().GetVectors().GetVectors()[keywordVectorName]; keywords != nil {
+				snapshotPoint.Keywords = &SparseVector{
+					Indices: keywords.GetIndices().GetData(),
+					Values:  keywords.GetData(),
+				}
+			}
+			batch = append(batch, snapshotPoint)
+		}
+		return fn(batch)
+	})
+}
+
+func (s *qdrantPointStore) UpsertPoints(ctx context.Context, points []*SnapshotPoint) error {
+	if s.hasKeywords == nil {
+		hasKeywords, err := collectionHasKeywords(ctx, s.client, s.collection)
+		if err != nil {
+			return err
+		}
+		s.hasKeywords = &hasKeywords
+	}
+
+	structs := make([]*qdrant.PointStruct, 0, len(points))
+	for _, point := range points {
+		payload, err := qdrant.TryValueMap(point.Payload)
+		if err != nil {
+			return fmt.Errorf("invalid payload of point %s: %w", point.ID, err)
+		}
+
+		vectors := qdrant.NewVectorsDense(point.Vector)
+		if *s.hasKeywords && point.Keywords != nil {
+			vectors = qdrant.NewVectorsMap(map[string]*qdrant.Vector{
+				"":                qdrant.NewVectorDense(point.Vector),
+				keywordVectorName: qdrant.NewVectorSparse(point.Keywords.Indices, point.Keywords.Values),
+			})
+		} else {
+			// The point is stored without its keyword vector, which is written
+			// the next time the page is indexed.
+			delete(payload, payloadKeywordEncoding)
+		}
+
+		structs = append(structs, &qdrant.PointStruct{
+			Id:      qdrant.NewIDUUID(point.ID),
+			Vectors: vectors,
+			Payload: payload,
+		})
+	}
+
+	_, err := s.client.Upsert(ctx, &qdrant.UpsertPoints{
+		CollectionName: s.collection,
+		Wait:           qdrant.PtrOf(true),
+		Points:         structs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert points: %w", err)
+	}
+
+	return nil
+}
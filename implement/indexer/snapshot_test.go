@@ -0,0 +1,115 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// memoryPointStore keeps points in memory, in insertion order.
+type memoryPointStore struct {
+	vectorSize uint64
+	distance   string
+	points     []*SnapshotPoint
+}
+
+func (s *memoryPointStore) Describe(ctx context.Context) (uint64, string, error) {
+	return s.vectorSize, s.distance, nil
+}
+
+func (s *memoryPointStore) ScanPoints(ctx context.Context, fn func([]*SnapshotPoint) error) error {
+	return fn(s.points)
+}
+
+func (s *memoryPointStore) UpsertPoints(ctx context.Context, points []*SnapshotPoint) error {
+	s.points = append(s.points, points...)
+	return nil
+}
+
+func testSnapshot(t *testing.T, source *memoryPointStore) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	count, err := ExportIndex(context.Background(), source, &buf)
+	if err != nil {
+		t.Fatalf("ExportIndex: %v", err)
+	}
+	if count != len(source.points) {
+		t.Fatalf("exported %d points, want %d", count, len(source.points))
+	}
+	return buf.Bytes()
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Setenv("OPENAI_EMBEDDING_MODEL", "text-embedding-3-small")
+
+	source := &memoryPointStore{vectorSize: 3, distance: "Cosine"}
+	source.points = []*SnapshotPoint{
+		{
+			ID:     "7f1c5b9e-2d4a-4c8e-9f3b-1a2b3c4d5e6f",
+			Vector: snapshotVector{0.1, -2.5, 3e-8},
+			Payload: map[string]any{
+				"source_url":          "https://shopify.dev/docs/apps",
+				payloadEmbeddingModel: "openai/text-embedding-3-small",
+				payloadBreadcrumbs:    []any{"docs", "apps"},
+			},
+		},
+		{
+			ID:       "0b6d9c2a-8e4f-4a1b-b7c3-d5e6f7a8b9c0",
+			Vector:   snapshotVector{1, 0, 0},
+			Keywords: &SparseVector{Indices: []uint32{4, 9}, Values: []float32{0.5, 1.25}},
+			Payload: map[string]any{
+				"source_order": int64(2),
+				"score":        0.75,
+				"nested":       map[string]any{"count": int64(3)},
+			},
+		},
+	}
+
+	target := &memoryPointStore{vectorSize: 3, distance: "cosine"}
+	count, err := ImportIndex(context.Background(), target, bytes.NewReader(testSnapshot(t, source)))
+	if err != nil {
+		t.Fatalf("ImportIndex: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("imported %d points, want 2", count)
+	}
+	if !reflect.DeepEqual(target.points, source.points) {
+		t.Fatalf("imported %#v, want %#v", target.points, source.points)
+	}
+}
+
+func TestImportIndexMismatch(t *testing.T) {
+	t.Setenv("OPENAI_EMBEDDING_MODEL", "text-embedding-3-large")
+
+	source := &memoryPointStore{vectorSize: 2, distance: "Cosine"}
+	source.points = []*SnapshotPoint{{
+		ID:      "7f1c5b9e-2d4a-4c8e-9f3b-1a2b3c4d5e6f",
+		Vector:  snapshotVector{1, 2},
+		Payload: map[string]any{payloadEmbeddingModel: "openai/text-embedding-3-small"},
+	}}
+	snapshot := testSnapshot(t, source)
+
+	tests := []struct {
+		name   string
+		target *memoryPointStore
+		want   string
+	}{
+		{"vector size", &memoryPointStore{vectorSize: 3, distance: "Cosine"}, "2-dimensional"},
+		{"distance", &memoryPointStore{vectorSize: 2, distance: "Dot"}, "Dot"},
+		{"model", &memoryPointStore{vectorSize: 2, distance: "Cosine"}, "text-embedding-3-small"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ImportIndex(context.Background(), tt.target, bytes.NewReader(snapshot))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("got error %v, want one mentioning %q", err, tt.want)
+			}
+			if len(tt.target.points) != 0 {
+				t.Fatalf("wrote %d points", len(tt.target.points))
+			}
+		})
+	}
+}
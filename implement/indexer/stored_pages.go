@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+// StoredPage is a page rebuilt from the payloads of its points.
+type StoredPage struct {
+	DocPage     *extractorv1.DocPage
+	Breadcrumbs []string
+	ApiVersion  string
+	ContentType string
+	// IndexedAt is when the page point was last written, in RFC 3339. It is
+	// empty for points written before it was stored.
+	IndexedAt string
+}
+
+// ListStoredPages rebuilds the indexed pages starting with prefix, sorted by
+// URL, with their sections in document order. With a filter expression,
+// only pages with a matching point are listed.
+func ListStoredPages(ctx context.Context, prefix string, filterExpression string) ([]*StoredPage, error) {
+	pageUrls, err := ListPageUrls(ctx, prefix, filterExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make(map[string]*StoredPage, len(pageUrls))
+	for _, pageUrl := range pageUrls {
+		pages[pageUrl] = &StoredPage{DocPage: &extractorv1.DocPage{SourceUrl: pageUrl}}
+	}
+
+	// Section titles are stored after the page title, which may be read after
+	// the sections.
+	sectionTitles := make(map[*extractorv1.DocSection]string)
+
+	// Pages are matched on the whole collection so that points without a
+	// page_url payload are found too.
+	err = scrollPoints(ctx, getQdrantClient(), collectionName(), nil, qdrant.NewWithPayload(true), false, func(points []*qdrant.RetrievedPoint) error {
+		for _, point := range points {
+			page, ok := pages[pointPageUrl(point.Payload)]
+			if !ok {
+				continue
+			}
+			payload := point.Payload
+
+			// Only section points have a source order.
+			if _, ok := payload["source_order"]; !ok {
+				page.DocPage.SourceTitle = payload["source_title"].GetStringValue()
+				page.DocPage.ContentMarkdown = payload["content"].GetStringValue()
+				page.IndexedAt = payload[payloadIndexedAt].GetStringValue()
+				continue
+			}
+
+			anchor := payload[payloadSectionAnchor].GetStringValue()
+			sourceUrl := payload["source_url"].GetStringValue()
+			if before, after, ok := strings.Cut(sourceUrl, "#"); anchor == "" && ok {
+				// Points written before section_anchor was stored.
+				sourceUrl, anchor = before, "#"+after
+			}
+			section := &extractorv1.DocSection{
+				Order:           int32(payload["source_order"].GetIntegerValue()),
+				SourceUrl:       strings.TrimSuffix(sourceUrl, anchor),
+				SectionAnchor:   anchor,
+				ContentMarkdown: payload["content"].GetStringValue(),
+			}
+			sectionTitles[section] = payload["source_title"].GetStringValue()
+			page.DocPage.DocSections = append(page.DocPage.DocSections, section)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*StoredPage, 0, len(pageUrls))
+	for _, pageUrl := range pageUrls {
+		page := pages[pageUrl]
+		page.Breadcrumbs, page.ApiVersion, page.ContentType = pageMetadata(pageUrl)
+
+		for _, section := range page.DocPage.DocSections {
+			section.SourceTitle = page.DocPage.SourceTitle
+			section.SectionTitle = strings.TrimPrefix(sectionTitles[section], page.DocPage.SourceTitle+"/")
+		}
+		sort.SliceStable(page.DocPage.DocSections, func(i, j int) bool {
+			return page.DocPage.DocSections[i].Order < page.DocPage.DocSections[j].Order
+		})
+
+		result = append(result, page)
+	}
+
+	return result, nil
+}
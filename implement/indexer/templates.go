@@ -0,0 +1,189 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"unicode/utf8"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+// Embedding templates render the text that is embedded for a page or a
+// section. The "page" and "section" templates are used by default; a
+// template named "page.<content type>" or "section.<content type>", such as
+// "section.mutation", replaces them for the pages of that content type.
+//
+// Templates are loaded from the *.tmpl files of INDEXER_TEMPLATE_DIR, named
+// after the file without its extension, and replace the built-in templates
+// of the same name. Changing a template changes the content hash of the
+// points it renders, so they are embedded again on the next index.
+
+const (
+	pageTemplateName    = "page"
+	sectionTemplateName = "section"
+)
+
+var builtinEmbeddingTemplates = map[string]string{
+	pageTemplateName: `---
+Source title: {{.SourceTitle}}
+Source URL: {{.SourceUrl}}
+Sections:
+{{range .DocSections}}
+  - [{{.SectionTitle}}]({{.SourceUrl}}{{.SectionAnchor}})
+{{end}}
+---
+
+{{.ContentMarkdown}}`,
+	sectionTemplateName: `---
+Source Title: {{.SourceTitle}} / {{.SectionTitle}}
+Source URL: {{.SourceUrl}}{{.SectionAnchor}}
+---
+
+{{.ContentMarkdown}}
+`,
+}
+
+// PageTemplateData is what page templates are executed with: every DocPage
+// field, and the metadata derived from its URL.
+type PageTemplateData struct {
+	*extractorv1.DocPage
+	Breadcrumbs []string
+	ApiVersion  string
+	ContentType string
+}
+
+// SectionTemplateData is what section templates are executed with: every
+// DocSection field, the page it belongs to and the metadata of the page.
+type SectionTemplateData struct {
+	*extractorv1.DocSection
+	Page        *extractorv1.DocPage
+	Breadcrumbs []string
+	ApiVersion  string
+	ContentType string
+}
+
+var fencedCodePattern = regexp.MustCompile("(?ms)^[ \t]*(```|~~~).*?^[ \t]*(```|~~~)[ \t]*$\n?")
+
+// templateFuncs are the helpers available in embedding templates.
+var templateFuncs = template.FuncMap{
+	// truncate shortens s to at most n characters, cutting at a word
+	// boundary when there is one: {{.ContentMarkdown | truncate 2000}}.
+	"truncate": func(n int, s string) string {
+		if utf8.RuneCountInString(s) <= n {
+			return s
+		}
+		cut := string([]rune(s)[:n])
+		if i := strings.LastIndexAny(cut, " \n\t"); i > n/2 {
+			cut = cut[:i]
+		}
+		return strings.TrimSpace(cut) + "…"
+	},
+	// stripCode removes fenced code blocks from Markdown.
+	"stripCode": func(s string) string {
+		return fencedCodePattern.ReplaceAllString(s, "")
+	},
+	// breadcrumbs joins breadcrumbs into a path such as
+	// "apps > launch > billing".
+	"breadcrumbs": func(breadcrumbs []string) string {
+		return strings.Join(breadcrumbs, " > ")
+	},
+	"join":  func(sep string, values []string) string { return strings.Join(values, sep) },
+	"trim":  strings.TrimSpace,
+	"lower": strings.ToLower,
+}
+
+// EmbeddingTemplates is a set of named, parsed embedding templates.
+type EmbeddingTemplates struct {
+	templates map[string]*template.Template
+}
+
+// LoadEmbeddingTemplates parses the built-in templates and the *.tmpl files
+// of dir, which replace the built-in ones of the same name. An empty dir only
+// loads the built-in templates.
+func LoadEmbeddingTemplates(dir string) (*EmbeddingTemplates, error) {
+	sources := make(map[string]string, len(builtinEmbeddingTemplates))
+	for name, source := range builtinEmbeddingTemplates {
+		sources[name] = source
+	}
+
+	if dir != "" {
+		paths, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+		if err != nil {
+			return nil, err
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no *.tmpl files in %s", dir)
+		}
+		for _, path := range paths {
+			source, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read template: %w", err)
+			}
+			sources[strings.TrimSuffix(filepath.Base(path), ".tmpl")] = string(source)
+		}
+	}
+
+	templates := &EmbeddingTemplates{templates: make(map[string]*template.Template, len(sources))}
+	for name, source := range sources {
+		tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template: %w", err)
+		}
+		templates.templates[name] = tmpl
+	}
+
+	return templates, nil
+}
+
+// lookup returns the template of kind ("page" or "section") for a content
+// type.
+func (t *EmbeddingTemplates) lookup(kind string, contentType string) *template.Template {
+	if tmpl, ok := t.templates[kind+"."+contentType]; ok {
+		return tmpl
+	}
+
+	return t.templates[kind]
+}
+
+func (t *EmbeddingTemplates) execute(tmpl *template.Template, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// RenderPage renders the embedded text of a page.
+func (t *EmbeddingTemplates) RenderPage(data PageTemplateData) (string, error) {
+	return t.execute(t.lookup(pageTemplateName, data.ContentType), data)
+}
+
+// RenderSection renders the embedded text of a section.
+func (t *EmbeddingTemplates) RenderSection(data SectionTemplateData) (string, error) {
+	return t.execute(t.lookup(sectionTemplateName, data.ContentType), data)
+}
+
+// getEmbeddingTemplates returns the templates loaded from
+// INDEXER_TEMPLATE_DIR. Servers call CheckEmbeddingTemplates at startup,
+// which reports a broken template as an error before this panics on it.
+var getEmbeddingTemplates = sync.OnceValue(func() *EmbeddingTemplates {
+	templates, err := LoadEmbeddingTemplates(os.Getenv("INDEXER_TEMPLATE_DIR"))
+	if err != nil {
+		panic(err)
+	}
+	return templates
+})
+
+// CheckEmbeddingTemplates loads the templates of INDEXER_TEMPLATE_DIR and
+// reports the first one that doesn't parse.
+func CheckEmbeddingTemplates() error {
+	_, err := LoadEmbeddingTemplates(os.Getenv("INDEXER_TEMPLATE_DIR"))
+	return err
+}
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+var templateTestPage = &extractorv1.DocPage{
+	SourceTitle:     "Billing",
+	SourceUrl:       "https://shopify.dev/docs/apps/launch/billing",
+	ContentMarkdown: "Charge merchants.",
+	DocSections: []*extractorv1.DocSection{{
+		SectionTitle:    "Subscriptions",
+		SourceTitle:     "Billing",
+		SourceUrl:       "https://shopify.dev/docs/apps/launch/billing",
+		SectionAnchor:   "#subscriptions",
+		ContentMarkdown: "Recurring charges.\n\n```graphql\nmutation { appSubscriptionCreate }\n```\n\nDone.",
+	}},
+}
+
+func TestBuiltinEmbeddingTemplates(t *testing.T) {
+	templates, err := LoadEmbeddingTemplates("")
+	if err != nil {
+		t.Fatalf("LoadEmbeddingTemplates: %v", err)
+	}
+
+	// The built-in templates must keep rendering the same text, or every
+	// stored point would be embedded again.
+	page, err := templates.RenderPage(PageTemplateData{DocPage: templateTestPage, ContentType: "guide"})
+	if err != nil {
+		t.Fatalf("RenderPage: %v", err)
+	}
+	wantPage := "---\nSource title: Billing\nSource URL: https://shopify.dev/docs/apps/launch/billing\nSections:\n\n" +
+		"  - [Subscriptions](https://shopify.dev/docs/apps/launch/billing#subscriptions)\n\n---\n\nCharge merchants."
+	if page != wantPage {
+		t.Errorf("got page\n%q\nwant\n%q", page, wantPage)
+	}
+
+	section, err := templates.RenderSection(SectionTemplateData{DocSection: templateTestPage.DocSections[0], Page: templateTestPage})
+	if err != nil {
+		t.Fatalf("RenderSection: %v", err)
+	}
+	if !strings.HasPrefix(section, "---\nSource Title: Billing / Subscriptions\nSource URL: https://shopify.dev/docs/apps/launch/billing#subscriptions\n---\n\nRecurring charges.") {
+		t.Errorf("unexpected section %q", section)
+	}
+}
+
+func TestEmbeddingTemplatesFromDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"section.guide.tmpl": "{{breadcrumbs .Breadcrumbs}} / {{.Page.SourceTitle}} / {{.SectionTitle}}\n{{.ContentMarkdown | stripCode | truncate 25}}",
+		"page.tmpl":          "{{.SourceTitle | lower}}: {{join \", \" .Breadcrumbs}}",
+	}
+	for name, source := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(source), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	templates, err := LoadEmbeddingTemplates(dir)
+	if err != nil {
+		t.Fatalf("LoadEmbeddingTemplates: %v", err)
+	}
+
+	page, err := templates.RenderPage(PageTemplateData{DocPage: templateTestPage, Breadcrumbs: []string{"apps", "launch"}})
+	if err != nil || page != "billing: apps, launch" {
+		t.Errorf("got page %q, %v", page, err)
+	}
+
+	data := SectionTemplateData{
+		DocSection:  templateTestPage.DocSections[0],
+		Page:        templateTestPage,
+		Breadcrumbs: []string{"apps", "launch"},
+		ContentType: "guide",
+	}
+	section, err := templates.RenderSection(data)
+	if want := "apps > launch / Billing / Subscriptions\nRecurring charges.…"; err != nil || section != want {
+		t.Errorf("got section %q, %v, want %q", section, err, want)
+	}
+
+	// Other content types keep the built-in section template.
+	data.ContentType = "mutation"
+	if section, err := templates.RenderSection(data); err != nil || !strings.HasPrefix(section, "---\nSource Title:") {
+		t.Errorf("got section %q, %v", section, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "broken.tmpl"), []byte("{{.SourceTitle"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadEmbeddingTemplates(dir); err == nil {
+		t.Error("expected an error for a template that doesn't parse")
+	}
+}
@@ -0,0 +1,135 @@
+package indexer
+
+import (
+	"context"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkoukk/tiktoken-go"
+)
+
+// Tokenizer counts the tokens of a text as an embedding model bills them.
+type Tokenizer interface {
+	CountTokens(text string) int
+	// Exact reports whether counts are the ones of the model, rather than
+	// an approximation.
+	Exact() bool
+}
+
+// tiktokenTokenizer counts tokens with the BPE encoding of an OpenAI model.
+type tiktokenTokenizer struct {
+	encoding *tiktoken.Tiktoken
+}
+
+func (t *tiktokenTokenizer) CountTokens(text string) int {
+	return len(t.encoding.EncodeOrdinary(text))
+}
+
+func (t *tiktokenTokenizer) Exact() bool {
+	return true
+}
+
+// approximateTokenizer assumes a token is about four characters of English
+// text, for models whose vocabulary isn't known.
+type approximateTokenizer struct{}
+
+func (approximateTokenizer) CountTokens(text string) int {
+	return estimateTokens(text)
+}
+
+func (approximateTokenizer) Exact() bool {
+	return false
+}
+
+// estimateTokens approximates the number of tokens of English text.
+func estimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
+
+// tokenizerLoadTimeout bounds the download of the tiktoken encoding, so a
+// server without internet access falls back to approximate counts instead
+// of waiting for it.
+const tokenizerLoadTimeout = 10 * time.Second
+
+// NewTokenizer returns the tokenizer of a model: its tiktoken encoding for
+// OpenAI models, and an approximation for other models or when the
+// encoding can't be loaded before ctx is done.
+//
+// tiktoken downloads the encoding on first use and caches it in
+// TIKTOKEN_CACHE_DIR, which can be populated ahead of time for servers
+// without internet access.
+func NewTokenizer(ctx context.Context, model string) Tokenizer {
+	if _, ok := tiktoken.MODEL_TO_ENCODING[model]; !ok {
+		return approximateTokenizer{}
+	}
+
+	type loadResult struct {
+		encoding *tiktoken.Tiktoken
+		err      error
+	}
+
+	// tiktoken downloads without a context, so stop waiting for it instead.
+	loaded := make(chan loadResult, 1)
+	go func() {
+		encoding, err := tiktoken.EncodingForModel(model)
+		loaded <- loadResult{encoding: encoding, err: err}
+	}()
+
+	select {
+	case result := <-loaded:
+		if result.err != nil {
+			log.Printf("failed to load the tiktoken encoding of %s, token counts are approximate: %v", model, result.err)
+			return approximateTokenizer{}
+		}
+		return &tiktokenTokenizer{encoding: result.encoding}
+	case <-ctx.Done():
+		log.Printf("timed out loading the tiktoken encoding of %s, token counts are approximate: %v", model, ctx.Err())
+		return approximateTokenizer{}
+	}
+}
+
+// getTokenizer returns the tokenizer of the configured embedding model.
+var getTokenizer = sync.OnceValue(func() Tokenizer {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenizerLoadTimeout)
+	defer cancel()
+
+	config, _ := loadOpenAIEmbeddingConfig()
+	return NewTokenizer(ctx, config.Model)
+})
+
+// LoadTokenizer loads the tokenizer of the configured embedding model, so
+// the first index request doesn't wait for its encoding to download.
+func LoadTokenizer() {
+	getTokenizer()
+}
+
+// openAIEmbeddingPrices are the prices of the OpenAI embedding models, in US
+// dollars per million input tokens.
+var openAIEmbeddingPrices = map[string]float64{
+	"text-embedding-3-small": 0.02,
+	"text-embedding-3-large": 0.13,
+	"text-embedding-ada-002": 0.10,
+}
+
+// embeddingPricePerMillionTokens is the price of the configured model from
+// OPENAI_EMBEDDING_PRICE_PER_MTOK, or the OpenAI price of the model. Models
+// without a known price, such as self-hosted ones, cost nothing.
+var embeddingPricePerMillionTokens = sync.OnceValue(func() float64 {
+	if value, err := strconv.ParseFloat(os.Getenv("OPENAI_EMBEDDING_PRICE_PER_MTOK"), 64); err == nil && value >= 0 {
+		return value
+	}
+
+	config, _ := loadOpenAIEmbeddingConfig()
+	return openAIEmbeddingPrices[config.Model]
+})
+
+// estimateCost returns the price in US dollars of embedding tokens tokens,
+// rounded to a millionth of a dollar.
+func estimateCost(tokens int64, pricePerMillionTokens float64) float64 {
+	return math.Round(float64(tokens)*pricePerMillionTokens) / 1e6
+}
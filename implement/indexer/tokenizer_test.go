@@ -0,0 +1,81 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pkoukk/tiktoken-go"
+)
+
+// byteBpeLoader is a BPE vocabulary of single bytes without merges, so tests
+// don't download the real one.
+type byteBpeLoader struct{}
+
+func (byteBpeLoader) LoadTiktokenBpe(string) (map[string]int, error) {
+	ranks := make(map[string]int, 256)
+	for b := 0; b < 256; b++ {
+		ranks[string([]byte{byte(b)})] = b
+	}
+	return ranks, nil
+}
+
+func TestNewTokenizer(t *testing.T) {
+	tiktoken.SetBpeLoader(byteBpeLoader{})
+	defer tiktoken.SetBpeLoader(tiktoken.NewDefaultBpeLoader())
+
+	tokenizer := NewTokenizer(context.Background(), "text-embedding-3-small")
+	if !tokenizer.Exact() {
+		t.Fatal("expected the tiktoken tokenizer for an OpenAI model")
+	}
+	if got := tokenizer.CountTokens("héllo"); got != 6 {
+		t.Errorf("got %d tokens, want one per byte", got)
+	}
+
+	tokenizer = NewTokenizer(context.Background(), "nomic-embed-text")
+	if tokenizer.Exact() {
+		t.Fatal("expected an approximate tokenizer for an unknown model")
+	}
+	if got := tokenizer.CountTokens("héllo world"); got != 3 {
+		t.Errorf("got %d tokens, want 3", got)
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	if got := estimateCost(2_500_000, 0.13); got != 0.325 {
+		t.Errorf("got %v, want 0.325", got)
+	}
+	if got := estimateCost(1, 0.02); got != 0 {
+		t.Errorf("got %v, want a cost rounded to a millionth of a dollar", got)
+	}
+}
+
+// blockingBpeLoader doesn't return until released, like a download from a
+// server that can't reach the internet.
+type blockingBpeLoader struct {
+	release  chan struct{}
+	returned chan struct{}
+}
+
+func (l blockingBpeLoader) LoadTiktokenBpe(string) (map[string]int, error) {
+	defer close(l.returned)
+	<-l.release
+	return nil, errors.New("released")
+}
+
+func TestNewTokenizerTimeout(t *testing.T) {
+	loader := blockingBpeLoader{release: make(chan struct{}), returned: make(chan struct{})}
+	tiktoken.SetBpeLoader(loader)
+	defer tiktoken.SetBpeLoader(tiktoken.NewDefaultBpeLoader())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	tokenizer := NewTokenizer(ctx, "davinci")
+	close(loader.release)
+	<-loader.returned
+	if tokenizer.Exact() {
+		t.Fatal("expected an approximate tokenizer when the encoding doesn't load in time")
+	}
+}
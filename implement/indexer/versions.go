@@ -0,0 +1,409 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	indexerv1 "github.com/aiocean/shopify-doc-extractor/gen/indexer/v1"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+// The configured collection name is an alias pointing at one of the
+// versioned collections <alias>-v1, <alias>-v2, ... Every read and write
+// goes through the alias, so a version built in the background goes live
+// when the alias is switched to it.
+
+// versionsMu serializes the changes to versions and to the alias.
+var versionsMu sync.Mutex
+
+func versionName(alias string, number int) string {
+	return fmt.Sprintf("%s-v%d", alias, number)
+}
+
+// parseVersionName returns the number of a version of alias, or false when
+// name is not a version of alias.
+func parseVersionName(alias, name string) (int, bool) {
+	suffix, ok := strings.CutPrefix(name, alias+"-v")
+	if !ok {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(suffix)
+	if err != nil || number <= 0 || strconv.Itoa(number) != suffix {
+		return 0, false
+	}
+
+	return number, true
+}
+
+// collectionVersions is the state of the versions of the alias.
+type collectionVersions struct {
+	alias string
+	// active is the collection the alias points to, the alias itself when
+	// it is a legacy collection, or empty when there is neither.
+	active string
+	// legacy is set when a collection has the name of the alias.
+	legacy bool
+	// numbers maps the existing versions to their number.
+	numbers map[string]int
+}
+
+func loadCollectionVersions(ctx context.Context, client *qdrant.Client) (*collectionVersions, error) {
+	alias := collectionName()
+
+	names, err := client.ListCollections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+
+	aliases, err := client.ListAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list aliases: %w", err)
+	}
+
+	versions := &collectionVersions{
+		alias:   alias,
+		numbers: make(map[string]int),
+	}
+	for _, name := range names {
+		if name == alias {
+			versions.legacy = true
+			versions.active = alias
+		} else if number, ok := parseVersionName(alias, name); ok {
+			versions.numbers[name] = number
+		}
+	}
+	for _, description := range aliases {
+		if description.AliasName == alias {
+			versions.active = description.CollectionName
+		}
+	}
+
+	return versions, nil
+}
+
+// sorted returns the version names ordered by number.
+func (v *collectionVersions) sorted() []string {
+	names := make([]string, 0, len(v.numbers))
+	for name := range v.numbers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return v.numbers[names[i]] < v.numbers[names[j]]
+	})
+
+	return names
+}
+
+func (v *collectionVersions) next() int {
+	next := 1
+	for _, number := range v.numbers {
+		next = max(next, number+1)
+	}
+
+	return next
+}
+
+func (v *collectionVersions) describe(ctx context.Context, client *qdrant.Client, name string) (*indexerv1.CollectionVersion, error) {
+	info, err := client.GetCollectionInfo(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection info of %s: %w", name, err)
+	}
+
+	return &indexerv1.CollectionVersion{
+		Name:        name,
+		Number:      int32(v.numbers[name]),
+		Active:      name == v.active,
+		Legacy:      v.legacy && name == v.alias,
+		PointsCount: info.GetPointsCount(),
+	}, nil
+}
+
+// ensureCollectionExists makes sure the alias resolves to a collection,
+// creating the first version and pointing the alias at it otherwise.
+func ensureCollectionExists(ctx context.Context, client *qdrant.Client) error {
+	alias := collectionName()
+
+	isCollectionExists, err := client.CollectionExists(ctx, alias)
+	if err != nil {
+		return err
+	}
+
+	if isCollectionExists {
+		return nil
+	}
+
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+
+	versions, err := loadCollectionVersions(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if versions.active != "" {
+		return nil
+	}
+
+	// The alias is missing. Point it at the newest version, creating one if
+	// there is none.
+	sorted := versions.sorted()
+	if len(sorted) == 0 {
+		name := versionName(alias, 1)
+		if err := getCollectionSchema().createCollection(ctx, client, name); err != nil {
+			return err
+		}
+		sorted = append(sorted, name)
+	}
+
+	return client.CreateAlias(ctx, alias, sorted[len(sorted)-1])
+}
+
+// checkCollectionVersion fails unless name is an existing version of the
+// alias.
+func checkCollectionVersion(ctx context.Context, client *qdrant.Client, name string) error {
+	if _, ok := parseVersionName(collectionName(), name); !ok {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%q is not a version of collection %s", name, collectionName()))
+	}
+
+	exists, err := client.CollectionExists(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("collection version %s doesn't exist", name))
+	}
+
+	return nil
+}
+
+// CreateCollectionVersion creates the next version of the collection from
+// the configured schema. The alias is left as it is.
+func CreateCollectionVersion(ctx context.Context) (*indexerv1.CollectionVersion, error) {
+	client := getQdrantClient()
+
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+
+	versions, err := loadCollectionVersions(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	number := versions.next()
+	name := versionName(versions.alias, number)
+	if err := getCollectionSchema().createCollection(ctx, client, name); err != nil {
+		return nil, fmt.Errorf("failed to create collection %s: %w", name, err)
+	}
+	versions.numbers[name] = number
+
+	return versions.describe(ctx, client, name)
+}
+
+// ActivateCollectionVersion points the alias at the version name in one
+// atomic alias update. A legacy collection holding the name of the alias is
+// deleted first when dropLegacy is set, and blocks the switch otherwise.
+func ActivateCollectionVersion(ctx context.Context, name string, dropLegacy bool) (*indexerv1.ActivateCollectionVersionResponse, error) {
+	client := getQdrantClient()
+
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+
+	return activateCollectionVersion(ctx, client, name, dropLegacy)
+}
+
+func activateCollectionVersion(ctx context.Context, client *qdrant.Client, name string, dropLegacy bool) (*indexerv1.ActivateCollectionVersionResponse, error) {
+	if err := checkCollectionVersion(ctx, client, name); err != nil {
+		return nil, err
+	}
+
+	versions, err := loadCollectionVersions(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// A version built with another schema would fail every request once
+	// live.
+	info, err := client.GetCollectionInfo(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection info of %s: %w", name, err)
+	}
+	if err := getCollectionSchema().validate(info); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	previous := versions.active
+	switch {
+	case versions.legacy:
+		if !dropLegacy {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("collection %s is a legacy collection, set drop_legacy to delete it and switch to versions", versions.alias))
+		}
+		// Qdrant can't have an alias and a collection of the same name, so
+		// requests fail until the alias is created.
+		if err := client.DeleteCollection(ctx, versions.alias); err != nil {
+			return nil, fmt.Errorf("failed to delete legacy collection %s: %w", versions.alias, err)
+		}
+		if err := client.CreateAlias(ctx, versions.alias, name); err != nil {
+			return nil, fmt.Errorf("failed to create alias %s: %w", versions.alias, err)
+		}
+		versions.legacy = false
+		previous = ""
+	case previous == "":
+		if err := client.CreateAlias(ctx, versions.alias, name); err != nil {
+			return nil, fmt.Errorf("failed to create alias %s: %w", versions.alias, err)
+		}
+	case previous != name:
+		if err := client.UpdateAliases(ctx, []*qdrant.AliasOperations{
+			qdrant.NewAliasDelete(versions.alias),
+			qdrant.NewAliasCreate(versions.alias, name),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to switch alias %s: %w", versions.alias, err)
+		}
+	}
+
+	versions.active = name
+	active, err := versions.describe(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexerv1.ActivateCollectionVersionResponse{
+		Previous: previous,
+		Active:   active,
+	}, nil
+}
+
+func (s *IndexerServer) ListCollectionVersions(
+	ctx context.Context,
+	req *connect.Request[indexerv1.ListCollectionVersionsRequest],
+) (*connect.Response[indexerv1.ListCollectionVersionsResponse], error) {
+	client := getQdrantClient()
+
+	versions, err := loadCollectionVersions(ctx, client)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	names := versions.sorted()
+	if versions.legacy {
+		names = append([]string{versions.alias}, names...)
+	}
+
+	res := &indexerv1.ListCollectionVersionsResponse{
+		Alias: versions.alias,
+	}
+	for _, name := range names {
+		version, err := versions.describe(ctx, client, name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		res.Versions = append(res.Versions, version)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *IndexerServer) CreateCollectionVersion(
+	ctx context.Context,
+	req *connect.Request[indexerv1.CreateCollectionVersionRequest],
+) (*connect.Response[indexerv1.CreateCollectionVersionResponse], error) {
+	version, err := CreateCollectionVersion(ctx)
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(&indexerv1.CreateCollectionVersionResponse{
+		Version: version,
+	}), nil
+}
+
+func (s *IndexerServer) ActivateCollectionVersion(
+	ctx context.Context,
+	req *connect.Request[indexerv1.ActivateCollectionVersionRequest],
+) (*connect.Response[indexerv1.ActivateCollectionVersionResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+
+	res, err := ActivateCollectionVersion(ctx, req.Msg.Name, req.Msg.DropLegacy)
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *IndexerServer) RollbackCollectionVersion(
+	ctx context.Context,
+	req *connect.Request[indexerv1.RollbackCollectionVersionRequest],
+) (*connect.Response[indexerv1.RollbackCollectionVersionResponse], error) {
+	client := getQdrantClient()
+
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+
+	versions, err := loadCollectionVersions(ctx, client)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	activeNumber, ok := versions.numbers[versions.active]
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the active collection is not a version, there is nothing to roll back to"))
+	}
+
+	target := ""
+	for _, name := range versions.sorted() {
+		if versions.numbers[name] < activeNumber {
+			target = name
+		}
+	}
+	if target == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no version older than %s to roll back to", versions.active))
+	}
+
+	res, err := activateCollectionVersion(ctx, client, target, false)
+	if err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	return connect.NewResponse(&indexerv1.RollbackCollectionVersionResponse{
+		Previous: res.Previous,
+		Active:   res.Active,
+	}), nil
+}
+
+func (s *IndexerServer) DeleteCollectionVersion(
+	ctx context.Context,
+	req *connect.Request[indexerv1.DeleteCollectionVersionRequest],
+) (*connect.Response[indexerv1.DeleteCollectionVersionResponse], error) {
+	client := getQdrantClient()
+
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+
+	if err := checkCollectionVersion(ctx, client, req.Msg.Name); err != nil {
+		return nil, connect.NewError(errorCode(err), err)
+	}
+
+	versions, err := loadCollectionVersions(ctx, client)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if versions.active == req.Msg.Name {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("collection version %s is active", req.Msg.Name))
+	}
+
+	if err := client.DeleteCollection(ctx, req.Msg.Name); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete collection %s: %w", req.Msg.Name, err))
+	}
+
+	return connect.NewResponse(&indexerv1.DeleteCollectionVersionResponse{}), nil
+}
@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseVersionName(t *testing.T) {
+	tests := []struct {
+		name   string
+		number int
+		ok     bool
+	}{
+		{"shopify-doc-v1", 1, true},
+		{"shopify-doc-v12", 12, true},
+		{"shopify-doc", 0, false},
+		{"shopify-doc-v0", 0, false},
+		{"shopify-doc-v01", 0, false},
+		{"shopify-doc-vx", 0, false},
+		{"other-v1", 0, false},
+	}
+
+	for _, tt := range tests {
+		number, ok := parseVersionName("shopify-doc", tt.name)
+		if number != tt.number || ok != tt.ok {
+			t.Errorf("parseVersionName(%q) = %d, %v, want %d, %v", tt.name, number, ok, tt.number, tt.ok)
+		}
+	}
+}
+
+func TestCollectionVersionsSorted(t *testing.T) {
+	versions := &collectionVersions{
+		alias: "shopify-doc",
+		numbers: map[string]int{
+			"shopify-doc-v10": 10,
+			"shopify-doc-v2":  2,
+			"shopify-doc-v1":  1,
+		},
+	}
+
+	if got, want := versions.sorted(), []string{"shopify-doc-v1", "shopify-doc-v2", "shopify-doc-v10"}; !slices.Equal(got, want) {
+		t.Errorf("sorted() = %q, want %q", got, want)
+	}
+	if got := versions.next(); got != 11 {
+		t.Errorf("next() = %d, want 11", got)
+	}
+}
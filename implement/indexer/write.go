@@ -0,0 +1,159 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+// pageWrite is every change indexing a page makes to the collection.
+type pageWrite struct {
+	upserts        []*qdrant.PointStruct
+	vectorUpdates  []*qdrant.PointVectors
+	payloadUpdates []*indexPoint
+	deletes        []*qdrant.PointId
+}
+
+func (w *pageWrite) empty() bool {
+	return len(w.upserts) == 0 && len(w.vectorUpdates) == 0 && len(w.payloadUpdates) == 0 && len(w.deletes) == 0
+}
+
+func (w *pageWrite) pointIds() []*qdrant.PointId {
+	ids := make([]*qdrant.PointId, 0, len(w.upserts)+len(w.payloadUpdates)+len(w.deletes))
+	for _, point := range w.upserts {
+		ids = append(ids, point.Id)
+	}
+	for _, point := range w.payloadUpdates {
+		ids = append(ids, qdrant.NewIDUUID(point.id))
+	}
+
+	// Vector updates always come with a payload update, so their IDs are
+	// already listed.
+	return append(ids, w.deletes...)
+}
+
+func (w *pageWrite) operations() []*qdrant.PointsUpdateOperation {
+	var operations []*qdrant.PointsUpdateOperation
+	if len(w.upserts) > 0 {
+		operations = append(operations, qdrant.NewPointsUpdateUpsert(&qdrant.PointsUpdateOperation_PointStructList{
+			Points: w.upserts,
+		}))
+	}
+
+	if len(w.vectorUpdates) > 0 {
+		operations = append(operations, qdrant.NewPointsUpdateUpdateVectors(&qdrant.PointsUpdateOperation_UpdateVectors{
+			Points: w.vectorUpdates,
+		}))
+	}
+
+	for _, point := range w.payloadUpdates {
+		operations = append(operations, qdrant.NewPointsUpdateOverwritePayload(&qdrant.PointsUpdateOperation_OverwritePayload{
+			Payload:        point.payload,
+			PointsSelector: qdrant.NewPointsSelector(qdrant.NewIDUUID(point.id)),
+		}))
+	}
+
+	if len(w.deletes) > 0 {
+		operations = append(operations, qdrant.NewPointsUpdateDeletePoints(&qdrant.PointsUpdateOperation_DeletePoints{
+			Points: qdrant.NewPointsSelectorIDs(w.deletes),
+		}))
+	}
+
+	return operations
+}
+
+// applyPageWrite sends every change of a page to the collection in a single
+// batch. Qdrant doesn't apply batches atomically, so the points the batch
+// touches are read first and restored if the batch fails.
+func applyPageWrite(ctx context.Context, client *qdrant.Client, collection string, write *pageWrite) error {
+	if write.empty() {
+		return nil
+	}
+
+	slots := getWriteSlots()
+	if err := slots.acquire(ctx); err != nil {
+		return err
+	}
+	defer slots.release()
+
+	ids := write.pointIds()
+	previous, err := client.Get(ctx, &qdrant.GetPoints{
+		CollectionName: collection,
+		Ids:            ids,
+		WithPayload:    qdrant.NewWithPayload(true),
+		WithVectors:    qdrant.NewWithVectors(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read points before writing: %w", err)
+	}
+
+	wait := true
+	_, err = client.UpdateBatch(ctx, &qdrant.UpdateBatchPoints{
+		CollectionName: collection,
+		Wait:           &wait,
+		Operations:     write.operations(),
+	})
+	if err == nil {
+		return nil
+	}
+
+	writeErr := fmt.Errorf("failed to write points: %w", err)
+	// The request context may be what failed the write, the rollback must
+	// still run.
+	if rollbackErr := restorePoints(context.WithoutCancel(ctx), client, collection, ids, previous); rollbackErr != nil {
+		return errors.Join(writeErr, fmt.Errorf("failed to roll back: %w", rollbackErr))
+	}
+
+	return writeErr
+}
+
+// restorePoints puts the points with the given IDs back to their previous
+// state: points that existed are rewritten, the others are deleted.
+func restorePoints(ctx context.Context, client *qdrant.Client, collection string, ids []*qdrant.PointId, previous []*qdrant.RetrievedPoint) error {
+	wait := true
+	_, err := client.UpdateBatch(ctx, &qdrant.UpdateBatchPoints{
+		CollectionName: collection,
+		Wait:           &wait,
+		Operations:     restoreOperations(ids, previous),
+	})
+
+	return err
+}
+
+// restoreOperations returns the operations that restore the points with the
+// given IDs from their previous state.
+func restoreOperations(ids []*qdrant.PointId, previous []*qdrant.RetrievedPoint) []*qdrant.PointsUpdateOperation {
+	existed := make(map[string]bool, len(previous))
+	restored := make([]*qdrant.PointStruct, 0, len(previous))
+	for _, point := range previous {
+		existed[point.Id.GetUuid()] = true
+		restored = append(restored, &qdrant.PointStruct{
+			Id:      point.Id,
+			Vectors: point.Vectors,
+			Payload: point.Payload,
+		})
+	}
+
+	var created []*qdrant.PointId
+	for _, id := range ids {
+		if !existed[id.GetUuid()] {
+			created = append(created, id)
+		}
+	}
+
+	var operations []*qdrant.PointsUpdateOperation
+	if len(restored) > 0 {
+		operations = append(operations, qdrant.NewPointsUpdateUpsert(&qdrant.PointsUpdateOperation_PointStructList{
+			Points: restored,
+		}))
+	}
+	if len(created) > 0 {
+		operations = append(operations, qdrant.NewPointsUpdateDeletePoints(&qdrant.PointsUpdateOperation_DeletePoints{
+			Points: qdrant.NewPointsSelectorIDs(created),
+		}))
+	}
+
+	return operations
+}
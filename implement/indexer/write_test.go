@@ -0,0 +1,84 @@
+package indexer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPageWrite(t *testing.T) {
+	write := &pageWrite{
+		upserts: []*qdrant.PointStruct{{Id: qdrant.NewIDUUID("embedded")}},
+		vectorUpdates: []*qdrant.PointVectors{
+			{Id: qdrant.NewIDUUID("keywords"), Vectors: qdrant.NewVectorsDense([]float32{1})},
+		},
+		payloadUpdates: []*indexPoint{
+			{id: "keywords", payload: map[string]*qdrant.Value{"content": qdrant.NewValueString("a")}},
+			{id: "renamed", payload: map[string]*qdrant.Value{"content": qdrant.NewValueString("b")}},
+		},
+		deletes: []*qdrant.PointId{qdrant.NewIDUUID("stale")},
+	}
+
+	if !(&pageWrite{}).empty() || write.empty() {
+		t.Fatalf("empty() = %v for a write with changes", write.empty())
+	}
+
+	var ids []string
+	for _, id := range write.pointIds() {
+		ids = append(ids, id.GetUuid())
+	}
+	if want := "[embedded keywords renamed stale]"; fmt.Sprint(ids) != want {
+		t.Errorf("pointIds = %v, want %s", ids, want)
+	}
+
+	var kinds []string
+	for _, operation := range write.operations() {
+		kinds = append(kinds, fmt.Sprintf("%T", operation.GetOperation()))
+	}
+	want := "[*qdrant.PointsUpdateOperation_Upsert *qdrant.PointsUpdateOperation_UpdateVectors_ " +
+		"*qdrant.PointsUpdateOperation_OverwritePayload_ *qdrant.PointsUpdateOperation_OverwritePayload_ " +
+		"*qdrant.PointsUpdateOperation_DeletePoints_]"
+	if fmt.Sprint(kinds) != want {
+		t.Errorf("operations = %v, want %s", kinds, want)
+	}
+}
+
+func TestRestoreOperations(t *testing.T) {
+	previous := []*qdrant.RetrievedPoint{{
+		Id:      qdrant.NewIDUUID("updated"),
+		Payload: map[string]*qdrant.Value{"content": qdrant.NewValueString("old")},
+		Vectors: qdrant.NewVectorsDense([]float32{0.5}),
+	}}
+	ids := []*qdrant.PointId{qdrant.NewIDUUID("updated"), qdrant.NewIDUUID("created")}
+
+	want := []*qdrant.PointsUpdateOperation{
+		qdrant.NewPointsUpdateUpsert(&qdrant.PointsUpdateOperation_PointStructList{
+			Points: []*qdrant.PointStruct{{
+				Id:      previous[0].Id,
+				Vectors: previous[0].Vectors,
+				Payload: previous[0].Payload,
+			}},
+		}),
+		qdrant.NewPointsUpdateDeletePoints(&qdrant.PointsUpdateOperation_DeletePoints{
+			Points: qdrant.NewPointsSelectorIDs([]*qdrant.PointId{qdrant.NewIDUUID("created")}),
+		}),
+	}
+
+	got := restoreOperations(ids, previous)
+	if len(got) != len(want) {
+		t.Fatalf("got %d operations, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("operation %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// Nothing existed: every point is deleted.
+	got = restoreOperations(ids, nil)
+	if len(got) != 1 || len(got[0].GetDeletePoints().GetPoints().GetPoints().GetIds()) != 2 {
+		t.Errorf("restoreOperations without previous points = %v", got)
+	}
+}
@@ -0,0 +1,242 @@
+// Package llmstxt renders documentation pages as the files of the llms.txt
+// proposal (https://llmstxt.org): llms.txt, an index of the pages with their
+// title, URL and a one-line summary, and llms-full.txt, the Markdown of
+// every page and its sections concatenated.
+package llmstxt
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+	"github.com/aiocean/shopify-doc-extractor/implement/extractor"
+)
+
+const (
+	// DefaultBaseURL is prepended to page URLs, which are stored relative to
+	// shopify.dev.
+	DefaultBaseURL = "https://shopify.dev"
+	defaultTitle   = "Shopify developer documentation"
+
+	// maxSummaryLength is the length summaries are truncated to, in
+	// characters.
+	maxSummaryLength = 200
+	// overviewGroup is the heading of the pages that aren't in a group.
+	overviewGroup = "Overview"
+)
+
+// Options configures the generated files.
+type Options struct {
+	// Prefix keeps only the pages whose URL starts with it. Pages are grouped
+	// in llms.txt by the first URL segment below it.
+	Prefix string
+	// Title and Description head both files. They default to a title and
+	// description derived from Prefix.
+	Title       string
+	Description string
+	// BaseURL is prepended to relative page URLs, DefaultBaseURL when empty.
+	BaseURL string
+}
+
+// Files are the generated files.
+type Files struct {
+	LlmsTxt     string
+	LlmsFullTxt string
+	// PageCount is the number of pages the files list.
+	PageCount int
+}
+
+// Generate renders pages as llms.txt and llms-full.txt. Pages are listed by
+// URL, and pages whose URL doesn't start with the prefix are left out.
+func Generate(pages []*extractorv1.DocPage, opts Options) *Files {
+	if opts.BaseURL == "" {
+		opts.BaseURL = DefaultBaseURL
+	}
+	if opts.Title == "" {
+		opts.Title = defaultTitle
+		if opts.Prefix != "" {
+			opts.Title += ": " + opts.Prefix
+		}
+	}
+	if opts.Description == "" {
+		opts.Description = "Pages of " + strings.TrimPrefix(opts.BaseURL, "https://") + opts.Prefix + ", converted to Markdown."
+	}
+
+	var selected []*extractorv1.DocPage
+	for _, page := range pages {
+		if strings.HasPrefix(page.SourceUrl, opts.Prefix) {
+			selected = append(selected, page)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].SourceUrl < selected[j].SourceUrl
+	})
+
+	header := fmt.Sprintf("# %s\n\n> %s\n", opts.Title, opts.Description)
+
+	var index strings.Builder
+	index.WriteString(header)
+	for _, group := range groupPages(selected, opts.Prefix) {
+		fmt.Fprintf(&index, "\n## %s\n\n", group.name)
+		for _, page := range group.pages {
+			fmt.Fprintf(&index, "- [%s](%s)", linkText(page), absoluteURL(opts.BaseURL, page.SourceUrl))
+			if summary := Summarize(page.ContentMarkdown); summary != "" {
+				index.WriteString(": " + summary)
+			}
+			index.WriteString("\n")
+		}
+	}
+
+	var full strings.Builder
+	full.WriteString(header)
+	for _, page := range selected {
+		fmt.Fprintf(&full, "\n---\n\n# %s\n\nSource: %s\n\n", linkText(page), absoluteURL(opts.BaseURL, page.SourceUrl))
+		if content := extractor.PageMarkdown(page); content != "" {
+			full.WriteString(content + "\n")
+		}
+	}
+
+	return &Files{
+		LlmsTxt:     index.String(),
+		LlmsFullTxt: full.String(),
+		PageCount:   len(selected),
+	}
+}
+
+type pageGroup struct {
+	name  string
+	pages []*extractorv1.DocPage
+}
+
+// groupPages groups pages sorted by URL by their first URL segment below the
+// prefix, when other pages are nested under that segment. The remaining
+// pages are listed first, as the overview.
+func groupPages(pages []*extractorv1.DocPage, prefix string) []pageGroup {
+	segments := make([]string, len(pages))
+	nested := make(map[string]bool)
+	for i, page := range pages {
+		segment, rest, ok := strings.Cut(relativePath(page.SourceUrl, prefix), "/")
+		segments[i] = segment
+		if ok && rest != "" {
+			nested[segment] = true
+		}
+	}
+
+	var overview []*extractorv1.DocPage
+	var groups []pageGroup
+	for i, page := range pages {
+		if !nested[segments[i]] {
+			overview = append(overview, page)
+			continue
+		}
+
+		name := groupName(segments[i])
+		if len(groups) == 0 || groups[len(groups)-1].name != name {
+			groups = append(groups, pageGroup{name: name})
+		}
+		groups[len(groups)-1].pages = append(groups[len(groups)-1].pages, page)
+	}
+
+	if len(overview) > 0 {
+		groups = append([]pageGroup{{name: overviewGroup, pages: overview}}, groups...)
+	}
+	return groups
+}
+
+// relativePath returns the path of pageUrl below prefix. A prefix that ends
+// inside a segment, like /docs/apps/bui, stands for its directory.
+func relativePath(pageUrl, prefix string) string {
+	path, _, _ := strings.Cut(pageUrl, "#")
+	base := strings.TrimSuffix(prefix, "/")
+	if path != base && !strings.HasPrefix(path, base+"/") {
+		base = base[:strings.LastIndex(base, "/")+1]
+	}
+
+	return strings.Trim(strings.TrimPrefix(path, base), "/")
+}
+
+// groupName turns a URL segment like app-extensions into App extensions.
+func groupName(segment string) string {
+	name := strings.ReplaceAll(segment, "-", " ")
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+func linkText(page *extractorv1.DocPage) string {
+	if page.SourceTitle == "" {
+		return page.SourceUrl
+	}
+	return page.SourceTitle
+}
+
+func absoluteURL(baseURL, pageUrl string) string {
+	if strings.HasPrefix(pageUrl, "/") {
+		return strings.TrimSuffix(baseURL, "/") + pageUrl
+	}
+	return pageUrl
+}
+
+var (
+	imagePattern    = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+	linkPattern     = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	emphasisPattern = regexp.MustCompile(`\*\*|__`)
+	sentencePattern = regexp.MustCompile(`[.!?]\s+\p{Lu}`)
+)
+
+// Summarize returns the first sentence of the first paragraph of text in
+// markdown, without links and emphasis, shortened to a line. Headings, code
+// blocks, lists, tables and quotes are skipped.
+func Summarize(markdown string) string {
+	inCode := false
+	for _, paragraph := range strings.Split(markdown, "\n\n") {
+		trimmed := strings.TrimSpace(paragraph)
+		fences := strings.Count(paragraph, "```") + strings.Count(paragraph, "~~~")
+		if inCode || fences > 0 {
+			if fences%2 == 1 {
+				inCode = !inCode
+			}
+			continue
+		}
+		if trimmed == "" || strings.ContainsAny(trimmed[:1], "#-*+|><") || startsWithNumber(trimmed) {
+			continue
+		}
+
+		text := imagePattern.ReplaceAllString(trimmed, "")
+		text = linkPattern.ReplaceAllString(text, "$1")
+		text = emphasisPattern.ReplaceAllString(text, "")
+		text = strings.Join(strings.Fields(text), " ")
+		if text == "" {
+			continue
+		}
+
+		if loc := sentencePattern.FindStringIndex(text); loc != nil {
+			text = text[:loc[0]+1]
+		}
+		return truncate(text, maxSummaryLength)
+	}
+
+	return ""
+}
+
+func startsWithNumber(text string) bool {
+	digits := strings.TrimLeftFunc(text, unicode.IsDigit)
+	return len(digits) < len(text) && (strings.HasPrefix(digits, ". ") || strings.HasPrefix(digits, ") "))
+}
+
+// truncate shortens text to at most n characters, cutting at a word boundary
+// and ending with an ellipsis.
+func truncate(text string, n int) string {
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+
+	cut := string([]rune(text)[:n-1])
+	if index := strings.LastIndex(cut, " "); index > 0 {
+		cut = cut[:index]
+	}
+	return strings.TrimRight(cut, " ,;:") + "…"
+}
@@ -0,0 +1,91 @@
+package llmstxt
+
+import (
+	"testing"
+
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	pages := []*extractorv1.DocPage{
+		{
+			SourceTitle:     "Billing",
+			SourceUrl:       "/docs/apps/launch/billing",
+			ContentMarkdown: "# Billing\n\nCharge merchants with the **Billing API**. Plans are recurring.\n\n## Sections\n\n- [Plans](/docs/apps/launch/billing#plans)\n- [Trials](/docs/apps/launch/billing#trials)",
+			DocSections: []*extractorv1.DocSection{
+				{Order: 1, SectionTitle: "Trials", SectionAnchor: "#trials", ContentMarkdown: "Free trials delay the first charge."},
+				{Order: 0, SectionTitle: "Plans", SectionAnchor: "#plans", ContentMarkdown: "## Plans\n\nPlans renew every 30 days."},
+			},
+		},
+		{
+			SourceTitle:     "Launch",
+			SourceUrl:       "/docs/apps/launch",
+			ContentMarkdown: "Get your app ready for merchants.",
+		},
+		{
+			SourceTitle:     "Apps",
+			SourceUrl:       "/docs/apps",
+			ContentMarkdown: "```js\nconst app = 1;\n\nconsole.log(app);\n```\n\nBuild [apps](/docs/apps/build) that extend Shopify.",
+		},
+		{
+			SourceTitle: "Storefront",
+			SourceUrl:   "/docs/storefronts",
+		},
+	}
+
+	files := Generate(pages, Options{Prefix: "/docs/apps"})
+
+	wantIndex := `# Shopify developer documentation: /docs/apps
+
+> Pages of shopify.dev/docs/apps, converted to Markdown.
+
+## Overview
+
+- [Apps](https://shopify.dev/docs/apps): Build apps that extend Shopify.
+
+## Launch
+
+- [Launch](https://shopify.dev/docs/apps/launch): Get your app ready for merchants.
+- [Billing](https://shopify.dev/docs/apps/launch/billing): Charge merchants with the Billing API.
+`
+	if files.LlmsTxt != wantIndex {
+		t.Errorf("llms.txt:\n%s\nwant:\n%s", files.LlmsTxt, wantIndex)
+	}
+
+	wantFull := "# Shopify developer documentation: /docs/apps\n\n" +
+		"> Pages of shopify.dev/docs/apps, converted to Markdown.\n\n" +
+		"---\n\n# Apps\n\nSource: https://shopify.dev/docs/apps\n\n" +
+		"```js\nconst app = 1;\n\nconsole.log(app);\n```\n\nBuild [apps](/docs/apps/build) that extend Shopify.\n\n" +
+		"---\n\n# Launch\n\nSource: https://shopify.dev/docs/apps/launch\n\nGet your app ready for merchants.\n\n" +
+		"---\n\n# Billing\n\nSource: https://shopify.dev/docs/apps/launch/billing\n\n" +
+		"# Billing\n\nCharge merchants with the **Billing API**. Plans are recurring.\n\n" +
+		"## Plans\n\nPlans renew every 30 days.\n\n## Trials\n\nFree trials delay the first charge.\n"
+	if files.LlmsFullTxt != wantFull {
+		t.Errorf("llms-full.txt:\n%s\nwant:\n%s", files.LlmsFullTxt, wantFull)
+	}
+
+	if files.PageCount != 3 {
+		t.Errorf("PageCount = %d, want 3", files.PageCount)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		markdown string
+		want     string
+	}{
+		{"", ""},
+		{"## Heading\n\n- item\n\n| a | b |", ""},
+		{"1. Step one\n\nThe e.g. case stays whole.", "The e.g. case stays whole."},
+		{"![logo](logo.png) Line one\nline two.", "Line one line two."},
+		{
+			"This summary is much longer than the limit and keeps going with words that repeat words that repeat words that repeat words that repeat words that repeat words that repeat words that repeat until the end",
+			"This summary is much longer than the limit and keeps going with words that repeat words that repeat words that repeat words that repeat words that repeat words that repeat words that repeat until…",
+		},
+	}
+	for _, tt := range tests {
+		if got := Summarize(tt.markdown); got != tt.want {
+			t.Errorf("Summarize(%q) = %q, want %q", tt.markdown, got, tt.want)
+		}
+	}
+}
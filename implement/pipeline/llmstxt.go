@@ -0,0 +1,55 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	pipelinev1 "github.com/aiocean/shopify-doc-extractor/gen/pipeline/v1"
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+	"github.com/aiocean/shopify-doc-extractor/implement/llmstxt"
+)
+
+// errorCode returns the code of a connect error, such as InvalidArgument for
+// a malformed filter, and Internal for other errors.
+func errorCode(err error) connect.Code {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Code()
+	}
+	return connect.CodeInternal
+}
+
+func (s *PipelineServer) GenerateLlmsTxt(
+	ctx context.Context,
+	req *connect.Request[pipelinev1.GenerateLlmsTxtRequest],
+) (*connect.Response[pipelinev1.GenerateLlmsTxtResponse], error) {
+	pages := req.Msg.Pages
+	if len(pages) > 0 && req.Msg.Filter != "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("filter only applies to indexed pages, not to pages"))
+	}
+
+	if len(pages) == 0 {
+		storedPages, err := indexer.ListStoredPages(ctx, req.Msg.Prefix, req.Msg.Filter)
+		if err != nil {
+			return nil, connect.NewError(errorCode(err), fmt.Errorf("failed to list pages: %w", err))
+		}
+		for _, page := range storedPages {
+			pages = append(pages, page.DocPage)
+		}
+	}
+
+	files := llmstxt.Generate(pages, llmstxt.Options{
+		Prefix:      req.Msg.Prefix,
+		Title:       req.Msg.Title,
+		Description: req.Msg.Description,
+		BaseURL:     shopifyDevUrl,
+	})
+
+	return connect.NewResponse(&pipelinev1.GenerateLlmsTxtResponse{
+		LlmsTxt:     files.LlmsTxt,
+		LlmsFullTxt: files.LlmsFullTxt,
+		PageCount:   int32(files.PageCount),
+	}), nil
+}
@@ -0,0 +1,28 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	extractorv1 "github.com/aiocean/shopify-doc-extractor/gen/extractor/v1"
+	pipelinev1 "github.com/aiocean/shopify-doc-extractor/gen/pipeline/v1"
+)
+
+func TestGenerateLlmsTxtInvalidArgument(t *testing.T) {
+	tests := []*pipelinev1.GenerateLlmsTxtRequest{
+		{Filter: `content_type =`},
+		{Filter: `content = "x"`},
+		{
+			Pages:  []*extractorv1.DocPage{{SourceUrl: "/docs/apps"}},
+			Filter: `content_type = "guide"`,
+		},
+	}
+
+	for _, req := range tests {
+		_, err := (&PipelineServer{}).GenerateLlmsTxt(context.Background(), connect.NewRequest(req))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("GenerateLlmsTxt(%v) = %v, want InvalidArgument", req, err)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"connectrpc.com/connect"
+	pipelinev1 "github.com/aiocean/shopify-doc-extractor/gen/pipeline/v1"
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// shopifyDevUrl is the origin the relative page URLs of the index are on.
+const shopifyDevUrl = "https://shopify.dev"
+
+// rebuilds holds the rebuilds started since the server started. Only one
+// runs at a time.
+var rebuilds = struct {
+	sync.Mutex
+	byId map[string]*pipelinev1.Rebuild
+}{
+	byId: make(map[string]*pipelinev1.Rebuild),
+}
+
+func (s *PipelineServer) Rebuild(
+	ctx context.Context,
+	req *connect.Request[pipelinev1.RebuildRequest],
+) (*connect.Response[pipelinev1.RebuildResponse], error) {
+	concurrency := int(req.Msg.Concurrency)
+	if concurrency <= 0 {
+		concurrency = getConcurrency()
+	}
+	concurrency = min(concurrency, maxConcurrency)
+
+	// Reserve the rebuild, then list the pages and create the version
+	// without holding the lock, which GetRebuild and running rebuilds need.
+	rebuild := &pipelinev1.Rebuild{
+		Id:    uuid.New().String(),
+		State: pipelinev1.Rebuild_STATE_RUNNING,
+	}
+	if err := reserveRebuild(rebuild); err != nil {
+		return nil, err
+	}
+
+	pageUrls, version, err := prepareRebuild(ctx)
+	if err != nil {
+		rebuilds.Lock()
+		delete(rebuilds.byId, rebuild.Id)
+		rebuilds.Unlock()
+		return nil, err
+	}
+
+	rebuilds.Lock()
+	rebuild.CollectionVersion = version
+	rebuild.PagesTotal = int32(len(pageUrls))
+	res := &pipelinev1.RebuildResponse{
+		Rebuild: proto.Clone(rebuild).(*pipelinev1.Rebuild),
+	}
+	rebuilds.Unlock()
+
+	// The rebuild outlives the request.
+	go runRebuild(context.WithoutCancel(ctx), rebuild.Id, pageUrls, concurrency, req.Msg)
+
+	return connect.NewResponse(res), nil
+}
+
+// reserveRebuild adds rebuild to the rebuilds unless another one is running.
+func reserveRebuild(rebuild *pipelinev1.Rebuild) error {
+	rebuilds.Lock()
+	defer rebuilds.Unlock()
+
+	for _, running := range rebuilds.byId {
+		if running.State == pipelinev1.Rebuild_STATE_RUNNING {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("rebuild %s is still running", running.Id))
+		}
+	}
+	rebuilds.byId[rebuild.Id] = rebuild
+
+	return nil
+}
+
+// prepareRebuild lists the pages to rebuild and creates the collection
+// version they are indexed into.
+func prepareRebuild(ctx context.Context) ([]string, string, error) {
+	pageUrls, err := indexer.ListPageUrls(ctx, "", "")
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list pages: %w", err))
+	}
+	if len(pageUrls) == 0 {
+		return nil, "", connect.NewError(connect.CodeFailedPrecondition, errors.New("the collection has no pages to rebuild"))
+	}
+
+	version, err := indexer.CreateCollectionVersion(ctx)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeOf(err), err)
+	}
+
+	return pageUrls, version.Name, nil
+}
+
+func (s *PipelineServer) GetRebuild(
+	ctx context.Context,
+	req *connect.Request[pipelinev1.GetRebuildRequest],
+) (*connect.Response[pipelinev1.GetRebuildResponse], error) {
+	rebuilds.Lock()
+	defer rebuilds.Unlock()
+
+	rebuild, ok := rebuilds.byId[req.Msg.Id]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("rebuild %q not found", req.Msg.Id))
+	}
+
+	return connect.NewResponse(&pipelinev1.GetRebuildResponse{
+		Rebuild: proto.Clone(rebuild).(*pipelinev1.Rebuild),
+	}), nil
+}
+
+// runRebuild extracts every page again and indexes it into the version of
+// the rebuild, then activates the version if asked to and every page
+// succeeded.
+func runRebuild(ctx context.Context, id string, pageUrls []string, concurrency int, req *pipelinev1.RebuildRequest) {
+	rebuilds.Lock()
+	collection := rebuilds.byId[id].CollectionVersion
+	rebuilds.Unlock()
+
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for index, pageUrl := range pageUrls {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result := extractAndIndex(ctx, index, shopifyDevUrl+pageUrl, req.Strict, indexer.IndexOptions{
+				Collection: collection,
+			})
+
+			rebuilds.Lock()
+			defer rebuilds.Unlock()
+
+			rebuild := rebuilds.byId[id]
+			rebuild.PagesDone++
+			rebuild.TokensEmbedded, rebuild.EstimatedCostUsd = addUsage(rebuild.TokensEmbedded, rebuild.EstimatedCostUsd, result)
+			if result.ErrorCode != "" {
+				rebuild.PagesFailed++
+				rebuild.Failures = append(rebuild.Failures, result)
+			}
+		}()
+	}
+	wg.Wait()
+
+	rebuilds.Lock()
+	failed := rebuilds.byId[id].PagesFailed
+	rebuilds.Unlock()
+
+	var activateErr error
+	if failed == 0 && req.Activate {
+		_, activateErr = indexer.ActivateCollectionVersion(ctx, collection, req.DropLegacy)
+	}
+
+	rebuilds.Lock()
+	defer rebuilds.Unlock()
+
+	rebuild := rebuilds.byId[id]
+	switch {
+	case failed > 0:
+		rebuild.State = pipelinev1.Rebuild_STATE_FAILED
+		rebuild.ErrorMessage = fmt.Sprintf("%d of %d pages failed, %s was not activated", failed, rebuild.PagesTotal, collection)
+	case activateErr != nil:
+		rebuild.State = pipelinev1.Rebuild_STATE_FAILED
+		rebuild.ErrorMessage = fmt.Sprintf("failed to activate %s: %v", collection, activateErr)
+	default:
+		rebuild.State = pipelinev1.Rebuild_STATE_SUCCEEDED
+		rebuild.Activated = req.Activate
+	}
+
+	log.Printf("rebuild %s of %s finished: %s", id, collection, rebuild.State)
+}
@@ -0,0 +1,27 @@
+package pipeline
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	pipelinev1 "github.com/aiocean/shopify-doc-extractor/gen/pipeline/v1"
+)
+
+func TestReserveRebuild(t *testing.T) {
+	defer clear(rebuilds.byId)
+
+	first := &pipelinev1.Rebuild{Id: "first", State: pipelinev1.Rebuild_STATE_RUNNING}
+	if err := reserveRebuild(first); err != nil {
+		t.Fatalf("reserveRebuild: %v", err)
+	}
+
+	second := &pipelinev1.Rebuild{Id: "second", State: pipelinev1.Rebuild_STATE_RUNNING}
+	if err := reserveRebuild(second); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("got %v while a rebuild is running, want FailedPrecondition", err)
+	}
+
+	first.State = pipelinev1.Rebuild_STATE_SUCCEEDED
+	if err := reserveRebuild(second); err != nil {
+		t.Errorf("reserveRebuild after the first finished: %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+
+	"connectrpc.com/connect"
+	pipelinev1 "github.com/aiocean/shopify-doc-extractor/gen/pipeline/v1"
+	"github.com/aiocean/shopify-doc-extractor/implement/extractor"
+	"github.com/aiocean/shopify-doc-extractor/implement/indexer"
+)
+
+const (
+	defaultConcurrency = 4
+	maxConcurrency     = 16
+	maxUrls            = 200
+)
+
+// getConcurrency returns the default number of pages processed at once, read
+// from PIPELINE_CONCURRENCY.
+var getConcurrency = sync.OnceValue(func() int {
+	concurrency, err := strconv.Atoi(os.Getenv("PIPELINE_CONCURRENCY"))
+	if err != nil || concurrency <= 0 {
+		return defaultConcurrency
+	}
+
+	return min(concurrency, maxConcurrency)
+})
+
+type PipelineServer struct{}
+
+func (s *PipelineServer) ExtractAndIndex(
+	ctx context.Context,
+	req *connect.Request[pipelinev1.ExtractAndIndexRequest],
+) (*connect.Response[pipelinev1.ExtractAndIndexResponse], error) {
+	if len(req.Msg.Urls) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("urls is required"))
+	}
+
+	if len(req.Msg.Urls) > maxUrls {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d urls can be processed at once, got %d", maxUrls, len(req.Msg.Urls)))
+	}
+
+	concurrency := int(req.Msg.Concurrency)
+	if concurrency <= 0 {
+		concurrency = getConcurrency()
+	}
+	concurrency = min(concurrency, maxConcurrency)
+
+	results := make([]*pipelinev1.PageResult, len(req.Msg.Urls))
+	semaphore := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for index, pageUrl := range req.Msg.Urls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				results[index] = failedResult(index, pageUrl, connect.CodeCanceled, ctx.Err())
+				return
+			}
+
+			results[index] = extractAndIndex(ctx, index, pageUrl, req.Msg.Strict, indexer.IndexOptions{
+				Force:  req.Msg.Force,
+				DryRun: req.Msg.DryRun,
+			})
+		}()
+	}
+	wg.Wait()
+
+	res := &pipelinev1.ExtractAndIndexResponse{
+		Results: results,
+	}
+	for _, result := range results {
+		res.TokensEmbedded, res.EstimatedCostUsd = addUsage(res.TokensEmbedded, res.EstimatedCostUsd, result)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// addUsage adds the tokens and cost of indexing a page to running totals.
+// Failed pages count too, since some of their points may have been embedded.
+func addUsage(tokens int64, cost float64, result *pipelinev1.PageResult) (int64, float64) {
+	tokens += result.GetIndexResponse().GetTokensEmbedded()
+	cost += result.GetIndexResponse().GetEstimatedCostUsd()
+
+	return tokens, math.Round(cost*1e6) / 1e6
+}
+
+func extractAndIndex(ctx context.Context, index int, pageUrl string, strict bool, opts indexer.IndexOptions) *pipelinev1.PageResult {
+	docPage, report, err := extractor.ParseDocPage(ctx, pageUrl)
+	if err != nil {
+		return failedResult(index, pageUrl, extractor.ErrorCode(ctx, err), fmt.Errorf("failed to extract page: %w", err))
+	}
+
+	if err := extractor.ReportError(report, strict); err != nil {
+		result := failedResult(index, pageUrl, connect.CodeFailedPrecondition, err)
+		result.Report = report
+		return result
+	}
+
+	indexResponse, err := indexer.IndexDocPage(ctx, docPage, opts)
+	if err != nil {
+		code := connect.CodeInternal
+		var indexErr *indexer.IndexError
+		if errors.As(err, &indexErr) {
+			code = indexErr.Code
+		}
+		result := failedResult(index, pageUrl, code, fmt.Errorf("failed to index page: %w", err))
+		result.Report = report
+		if indexErr != nil {
+			result.IndexResponse = indexErr.Response
+		}
+		return result
+	}
+
+	return &pipelinev1.PageResult{
+		Url:           pageUrl,
+		Index:         int32(index),
+		Report:        report,
+		IndexResponse: indexResponse,
+	}
+}
+
+func failedResult(index int, pageUrl string, code connect.Code, err error) *pipelinev1.PageResult {
+	return &pipelinev1.PageResult{
+		Url:          pageUrl,
+		Index:        int32(index),
+		ErrorCode:    code.String(),
+		ErrorMessage: err.Error(),
+	}
+}